@@ -0,0 +1,253 @@
+package interval
+
+import (
+	"fmt"
+	"time"
+)
+
+// OpKind is the kind of a write operation applied to an interval tree by an Op.
+type OpKind uint8
+
+const (
+	// OpInsert inserts the operation values with the operation interval key, as the Insert method does,
+	// keeping the bounds of an existing interval key.
+	OpInsert OpKind = iota
+	// OpUpsert inserts the operation values with the operation interval key, replacing any
+	// existing values, as the Upsert method does.
+	OpUpsert
+	// OpDelete removes the operation interval key from the tree, as the Delete method does.
+	OpDelete
+)
+
+// String returns a string representation of the OpKind k.
+func (k OpKind) String() string {
+	switch k {
+	case OpInsert:
+		return "insert"
+	case OpUpsert:
+		return "upsert"
+	case OpDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("OpKind(%d)", uint8(k))
+	}
+}
+
+// UnknownOpKindError is a description of an operation which kind isn't one of the OpKind constants.
+type UnknownOpKindError string
+
+// Error returns a string representation of the UnknownOpKindError error.
+func (e UnknownOpKindError) Error() string {
+	return string(e)
+}
+
+func newUnknownOpKindError[V, T any](op Op[V, T]) error {
+	s := fmt.Sprintf("interval search tree: cannot apply operation of unknown kind %v for interval (%v, %v)", op.Kind, op.Start, op.End)
+	return UnknownOpKindError(s)
+}
+
+// Op is a write operation applied to an interval tree by the Apply method.
+// Val is used by operations on a SearchTree, and Vals by operations on a MultiValueSearchTree;
+// both are ignored by delete operations.
+//
+// ExpiresAt is the time at which the entry of an insert or upsert operation expires, or zero if it never expires,
+// as if it were inserted with InsertWithTTL. An insert operation on a MultiValueSearchTree keeps the expiry time
+// of an existing interval key, as it keeps its bounds.
+type Op[V, T any] struct {
+	Kind       OpKind
+	Start, End T
+	Val        V
+	Vals       []V
+	ExpiresAt  time.Time
+}
+
+// InsertBatch inserts the given entries as if Insert were called for each entry, in order,
+// but under a single write lock, so that readers never see some of the entries and not the others.
+// Entries are inserted with the bounds configured for the tree; their Bounds are ignored.
+// The ExpiresAt of each entry is kept as it is, as Load does.
+//
+// InsertBatch returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// in which case no entry is inserted.
+func (st *SearchTree[V, T]) InsertBatch(entries []Entry[V, T]) error {
+	ops := make([]Op[V, T], len(entries))
+	for i, e := range entries {
+		ops[i] = Op[V, T]{Kind: OpInsert, Start: e.Start, End: e.End, Val: e.Val, ExpiresAt: e.ExpiresAt}
+	}
+
+	return st.Apply(ops)
+}
+
+// DeleteBatch removes the interval keys of the given entries as if Delete were called for each entry, in order,
+// but under a single write lock, so that readers never see some of the interval keys removed and not the others.
+// Only the Start and End of the entries are used.
+//
+// DeleteBatch returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// in which case no interval key is removed.
+func (st *SearchTree[V, T]) DeleteBatch(entries []Entry[V, T]) error {
+	return st.Apply(deleteOps(entries))
+}
+
+// Apply applies the given operations to the tree, in order, under a single write lock,
+// so that readers never see some of the operations applied and not the others.
+// The Vals of the operations are ignored.
+//
+// All the operations are validated before any of them is applied, so Apply returns an UnknownOpKindError
+// if the kind of any operation is unknown, or an InvalidIntervalError if the end of any operation
+// is less than or equal to its start, in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) Apply(ops []Op[V, T]) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	intervals, err := opIntervals(ops, st.config, st.cmp, false)
+	if err != nil {
+		return err
+	}
+
+	for i, op := range ops {
+		switch op.Kind {
+		case OpInsert, OpUpsert:
+			st.root = upsert(st.root, intervals[i], st.cmp)
+		case OpDelete:
+			st.root = delete(st.root, intervals[i], st.cmp)
+		}
+
+		if st.root != nil {
+			st.root.Color = black
+		}
+	}
+
+	return nil
+}
+
+// InsertBatch inserts the given entries as if Insert were called for each entry, in order,
+// but under a single write lock, so that readers never see some of the entries and not the others.
+// Entries are inserted with the bounds configured for the tree; their Bounds are ignored.
+// The ExpiresAt of each entry is kept as it is, as Load does, unless its interval key is already in the tree.
+//
+// InsertBatch returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// an EmptyValueListError if any entry has an empty list of values, or a DuplicateValueError if the tree is configured
+// with TreeWithValueEquality and any value is already stored under its interval key, as Insert does,
+// in which case no entry is inserted.
+func (st *MultiValueSearchTree[V, T]) InsertBatch(entries []Entry[V, T]) error {
+	ops := make([]Op[V, T], len(entries))
+	for i, e := range entries {
+		ops[i] = Op[V, T]{Kind: OpInsert, Start: e.Start, End: e.End, Vals: e.Vals, ExpiresAt: e.ExpiresAt}
+	}
+
+	return st.Apply(ops)
+}
+
+// DeleteBatch removes the interval keys of the given entries as if Delete were called for each entry, in order,
+// but under a single write lock, so that readers never see some of the interval keys removed and not the others.
+// Only the Start and End of the entries are used.
+//
+// DeleteBatch returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// in which case no interval key is removed.
+func (st *MultiValueSearchTree[V, T]) DeleteBatch(entries []Entry[V, T]) error {
+	return st.Apply(deleteOps(entries))
+}
+
+// Apply applies the given operations to the tree, in order, under a single write lock,
+// so that readers never see some of the operations applied and not the others.
+// The Val of the operations is ignored.
+//
+// All the operations are validated before any of them is applied, so Apply returns an UnknownOpKindError
+// if the kind of any operation is unknown, an InvalidIntervalError if the end of any operation is less than
+// or equal to its start, or an EmptyValueListError if any insert or upsert operation has an empty list of values,
+// in which case the tree is left unchanged.
+//
+// If the tree is configured with TreeWithValueEquality, Apply returns a DuplicateValueError if any insert operation
+// has a value already stored under its interval key, or any insert or upsert operation has a repeated value,
+// as the Insert and Upsert methods do, in which case the tree is also left unchanged.
+func (st *MultiValueSearchTree[V, T]) Apply(ops []Op[V, T]) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	intervals, err := opIntervals(ops, st.config, st.cmp, true)
+	if err != nil {
+		return err
+	}
+
+	eq := valueSetEqual[V](st.config)
+
+	// Duplicate values are only found while applying the operations, so they are applied
+	// to a copy of the tree, which replaces the tree once all of them are applied.
+	root := st.root
+	if eq != nil && root != nil {
+		root.shared.Store(true)
+	}
+
+	for i, op := range ops {
+		switch op.Kind {
+		case OpInsert:
+			if eq != nil {
+				if dup, ok := duplicateValue(root, intervals[i], eq, st.cmp); ok {
+					return newDuplicateValueError(intervals[i], dup)
+				}
+			}
+			root = insert(root, intervals[i], false, st.cmp)
+		case OpUpsert:
+			if eq != nil {
+				if dup, ok := duplicateValue(nil, intervals[i], eq, st.cmp); ok {
+					return newDuplicateValueError(intervals[i], dup)
+				}
+			}
+			root = upsert(root, intervals[i], st.cmp)
+		case OpDelete:
+			root = delete(root, intervals[i], st.cmp)
+		}
+
+		if root != nil {
+			root.Color = black
+		}
+	}
+
+	st.root = root
+
+	return nil
+}
+
+func deleteOps[V, T any](entries []Entry[V, T]) []Op[V, T] {
+	ops := make([]Op[V, T], len(entries))
+	for i, e := range entries {
+		ops[i] = Op[V, T]{Kind: OpDelete, Start: e.Start, End: e.End}
+	}
+	return ops
+}
+
+// opIntervals validates the given operations and returns the interval each one of them applies.
+func opIntervals[V, T any](ops []Op[V, T], config TreeConfig, cmp CmpFunc[T], multi bool) ([]interval[V, T], error) {
+	intervals := make([]interval[V, T], len(ops))
+	for i, op := range ops {
+		it := interval[V, T]{
+			Start:      op.Start,
+			End:        op.End,
+			AllowPoint: config.allowIntervalPoint,
+			Bounds:     config.bounds,
+		}
+
+		if op.Kind > OpDelete {
+			return nil, newUnknownOpKindError(op)
+		}
+
+		if it.isInvalid(cmp) {
+			return nil, newInvalidIntervalError(it)
+		}
+
+		if op.Kind != OpDelete {
+			it.ExpiresAt = op.ExpiresAt
+			if multi {
+				it.Vals = op.Vals
+				if len(it.Vals) == 0 {
+					return nil, newEmptyValueListError(it, op.Kind.String())
+				}
+			} else {
+				it.Val = op.Val
+			}
+		}
+
+		intervals[i] = it
+	}
+
+	return intervals, nil
+}
@@ -0,0 +1,192 @@
+package interval
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSearchTree_Apply(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 3, "node1")
+
+	err := st.Apply([]Op[string, int]{
+		{Kind: OpInsert, Start: 4, End: 8, Val: "node2"},
+		{Kind: OpUpsert, Start: 1, End: 3, Val: "node3"},
+		{Kind: OpInsert, Start: 5, End: 7, Val: "node4"},
+		{Kind: OpDelete, Start: 4, End: 8},
+		{Kind: OpDelete, Start: 10, End: 12},
+	})
+	if err != nil {
+		t.Fatalf("st.Apply: got unexpected error %v", err)
+	}
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 3, Val: "node3"},
+		{Start: 5, End: 7, Val: "node4"},
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Apply: got unexpected entries %v; want %v", got, want)
+	}
+
+	err = st.Apply([]Op[string, int]{
+		{Kind: OpDelete, Start: 1, End: 3},
+		{Kind: OpInsert, Start: 9, End: 9, Val: "node5"},
+	})
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.Apply: got error type %T; want it to be %T", err, wantErr)
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Apply: got unexpected entries %v after error; want %v", got, want)
+	}
+
+	err = st.Apply([]Op[string, int]{
+		{Kind: OpDelete, Start: 1, End: 3},
+		{Kind: OpDelete + 1, Start: 5, End: 9},
+	})
+	var wantKindErr UnknownOpKindError
+	if !errors.As(err, &wantKindErr) {
+		t.Errorf("st.Apply: got error type %T; want it to be %T", err, wantKindErr)
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Apply: got unexpected entries %v after error; want %v", got, want)
+	}
+}
+
+func TestSearchTree_InsertBatch_DeleteBatch(t *testing.T) {
+	st := NewSearchTreeWithOptions[int](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	defer mustBeValidTree(t, st.root)
+
+	entries := make([]Entry[int, int], 100)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Start: i, End: i + 5, Val: i}
+	}
+
+	if err := st.InsertBatch(entries); err != nil {
+		t.Fatalf("st.InsertBatch: got unexpected error %v", err)
+	}
+
+	if got, want := st.Size(), len(entries); got != want {
+		t.Errorf("st.Size(): got unexpected value %v; want %v", got, want)
+	}
+
+	if e, _ := st.MinEntry(); e.Bounds != HalfOpen {
+		t.Errorf("st.MinEntry(): got unexpected bounds %v; want %v", e.Bounds, HalfOpen)
+	}
+
+	if err := st.DeleteBatch(entries[10:]); err != nil {
+		t.Fatalf("st.DeleteBatch: got unexpected error %v", err)
+	}
+
+	if got, want := entriesOf(st), entries[:10]; len(got) != len(want) || got[9].Val != want[9].Val {
+		t.Errorf("st.DeleteBatch: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_InsertBatch_Atomic(t *testing.T) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+
+	entries := make([]Entry[int, int], 50)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Start: i, End: i + 1, Val: i}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			st.InsertBatch(entries)
+			st.DeleteBatch(entries)
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		if n := st.Size(); n != 0 && n != len(entries) {
+			t.Fatalf("st.Size(): got partially applied batch with %v entries", n)
+		}
+	}
+
+	wg.Wait()
+}
+
+func TestMultiValueSearchTree_Apply(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	err := st.Apply([]Op[string, int]{
+		{Kind: OpInsert, Start: 1, End: 3, Vals: []string{"node1"}},
+		{Kind: OpInsert, Start: 1, End: 3, Vals: []string{"node2"}},
+		{Kind: OpInsert, Start: 4, End: 8, Vals: []string{"node3"}},
+		{Kind: OpUpsert, Start: 4, End: 8, Vals: []string{"node4"}},
+		{Kind: OpInsert, Start: 5, End: 6, Vals: []string{"node5"}},
+		{Kind: OpDelete, Start: 5, End: 6},
+	})
+	if err != nil {
+		t.Fatalf("st.Apply: got unexpected error %v", err)
+	}
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 3, Vals: []string{"node1", "node2"}},
+		{Start: 4, End: 8, Vals: []string{"node4"}},
+	}
+
+	var got []Entry[string, int]
+	for _, e := range st.All() {
+		got = append(got, e)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Apply: got unexpected entries %v; want %v", got, want)
+	}
+
+	err = st.Apply([]Op[string, int]{
+		{Kind: OpDelete, Start: 1, End: 3},
+		{Kind: OpUpsert, Start: 9, End: 10},
+	})
+	var wantErr EmptyValueListError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.Apply: got error type %T; want it to be %T", err, wantErr)
+	}
+
+	if got, want := st.Size(), 2; got != want {
+		t.Errorf("st.Size(): got unexpected value %v after error; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_InsertBatch_DeleteBatch(t *testing.T) {
+	st := NewMultiValueSearchTree[int](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	entries := []Entry[int, int]{
+		{Start: 1, End: 2, Vals: []int{1}},
+		{Start: 1, End: 2, Vals: []int{2, 3}},
+		{Start: 2, End: 4, Vals: []int{4}},
+	}
+
+	if err := st.InsertBatch(entries); err != nil {
+		t.Fatalf("st.InsertBatch: got unexpected error %v", err)
+	}
+
+	if got, want := st.CountIntersectionValues(0, 10), 4; got != want {
+		t.Errorf("st.CountIntersectionValues(0, 10): got unexpected value %v; want %v", got, want)
+	}
+
+	if err := st.DeleteBatch(entries[:1]); err != nil {
+		t.Fatalf("st.DeleteBatch: got unexpected error %v", err)
+	}
+
+	if got, want := st.Size(), 1; got != want {
+		t.Errorf("st.Size(): got unexpected value %v; want %v", got, want)
+	}
+
+	err := st.InsertBatch([]Entry[int, int]{{Start: 5, End: 6}})
+	var wantErr EmptyValueListError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.InsertBatch: got error type %T; want it to be %T", err, wantErr)
+	}
+}
@@ -0,0 +1,391 @@
+package interval
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+// The binary format of a tree only stores its entries and its config, regardless of the layout of its nodes.
+// It's made of a header, a payload and a trailer:
+//
+//   - The header holds the binaryMagic bytes, the version of the format, the kind of the tree
+//     (1 for SearchTree and 2 for MultiValueSearchTree), a flags byte in which the lowest bit
+//     tells whether interval points are allowed, and the bounds of the tree.
+//   - The payload is a gob stream with the number of entries followed by every entry,
+//     encoded one by one as a binaryEntry, in strictly ascending interval key order.
+//   - The trailer is the big-endian CRC-32 (IEEE) checksum of the header and the payload.
+const (
+	binaryVersion    = 1
+	binaryHeaderSize = 8
+	binaryCRCSize    = 4
+)
+
+// binaryMagic starts with a zero byte, which never starts a gob stream,
+// so that trees encoded with GobEncode can be told apart.
+var binaryMagic = [4]byte{0, 'I', 'S', 'T'}
+
+const (
+	binaryKindSearchTree           byte = 1
+	binaryKindMultiValueSearchTree byte = 2
+)
+
+const binaryFlagIntervalPoint byte = 1 << 0
+
+// InvalidEncodingError is a description of an invalid binary representation of a tree,
+// such as a truncated or corrupted one.
+type InvalidEncodingError string
+
+// Error returns a string representation of the InvalidEncodingError error.
+func (e InvalidEncodingError) Error() string {
+	return string(e)
+}
+
+func newInvalidEncodingError(format string, args ...any) error {
+	return InvalidEncodingError("interval: invalid binary encoding: " + fmt.Sprintf(format, args...))
+}
+
+// binaryEntry is the gob representation of an entry in the binary format.
+type binaryEntry[V, T any] struct {
+	Start     T
+	End       T
+	Bounds    Bounds
+	Val       V
+	Vals      []V
+	ExpiresAt time.Time
+}
+
+// MarshalBinary encodes the tree in a versioned binary format (compatible with [encoding.BinaryMarshaler]),
+// which only stores the entries of the tree in ascending interval key order and its config flags,
+// followed by a checksum, so that it doesn't depend on the internal layout of the tree.
+//
+// Unlike GobEncode, the values and the interval keys of the tree are the only types encoded with gob.
+func (st *SearchTree[V, T]) MarshalBinary() ([]byte, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return marshalBinary(binaryKindSearchTree, st.root, st.config)
+}
+
+// UnmarshalBinary decodes the tree from its binary representation (compatible with [encoding.BinaryUnmarshaler]),
+// as encoded by MarshalBinary, replacing the content and the config flags of the tree.
+// The tree is rebuilt in linear time. For migration purposes, it also accepts the representation encoded by GobEncode,
+// whose entries are validated and rebuilt into a tree as well, regardless of the decoded nodes.
+//
+// UnmarshalBinary returns a TypeMismatchError if data is the representation of another type of tree,
+// or an InvalidEncodingError if data is truncated, corrupted or of an unsupported version,
+// in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) UnmarshalBinary(data []byte) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if !bytes.HasPrefix(data, binaryMagic[:]) {
+		return unmarshalLegacy(data, binaryKindSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+	}
+
+	return unmarshalBinary(data, binaryKindSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+}
+
+// MarshalBinary encodes the tree in a versioned binary format (compatible with [encoding.BinaryMarshaler]),
+// which only stores the entries of the tree in ascending interval key order and its config flags,
+// followed by a checksum, so that it doesn't depend on the internal layout of the tree.
+//
+// Unlike GobEncode, the values and the interval keys of the tree are the only types encoded with gob.
+func (st *MultiValueSearchTree[V, T]) MarshalBinary() ([]byte, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return marshalBinary(binaryKindMultiValueSearchTree, st.root, st.config)
+}
+
+// UnmarshalBinary decodes the tree from its binary representation (compatible with [encoding.BinaryUnmarshaler]),
+// as encoded by MarshalBinary, replacing the content and the config flags of the tree.
+// The tree is rebuilt in linear time. For migration purposes, it also accepts the representation encoded by GobEncode,
+// whose entries are validated and rebuilt into a tree as well, regardless of the decoded nodes.
+//
+// UnmarshalBinary returns a TypeMismatchError if data is the representation of another type of tree,
+// or an InvalidEncodingError if data is truncated, corrupted or of an unsupported version,
+// in which case the tree is left unchanged.
+func (st *MultiValueSearchTree[V, T]) UnmarshalBinary(data []byte) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if !bytes.HasPrefix(data, binaryMagic[:]) {
+		return unmarshalLegacy(data, binaryKindMultiValueSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+	}
+
+	return unmarshalBinary(data, binaryKindMultiValueSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+}
+
+// unmarshalLegacy decodes the gob representation of a tree, as encoded by GobEncode,
+// leaving the given root and config unchanged on error.
+// As the decoded nodes may not hold a valid tree, their intervals are validated as the entries
+// of the binary format are, and the tree is rebuilt from them in linear time.
+func unmarshalLegacy[V, T any](data []byte, wantKind byte, wantTypeName string, root **node[V, T], config *TreeConfig, cmp CmpFunc[T]) error {
+	if cmp == nil {
+		return errNilCmp
+	}
+
+	var n *node[V, T]
+	cfg := *config
+	if err := gobDecode(data, wantTypeName, &n, &cfg); err != nil {
+		if _, ok := err.(TypeMismatchError); ok {
+			return err
+		}
+		return newInvalidEncodingError("cannot decode gob representation: %v", err)
+	}
+
+	if cfg.bounds > Open {
+		return newInvalidEncodingError("unknown bounds %d", cfg.bounds)
+	}
+
+	var intervals []interval[V, T]
+	var err error
+	inOrder(n, 0, func(_ int, it interval[V, T]) bool {
+		it.AllowPoint = cfg.allowIntervalPoint
+
+		var prev *interval[V, T]
+		if len(intervals) > 0 {
+			prev = &intervals[len(intervals)-1]
+		}
+
+		if err = validateBinaryEntry(it, prev, cmp, wantKind == binaryKindMultiValueSearchTree); err != nil {
+			return false
+		}
+
+		intervals = append(intervals, it)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	*root, *config = buildFromSorted(len(intervals), slicePuller(intervals), cmp), cfg
+
+	return nil
+}
+
+func marshalBinary[V, T any](kind byte, root *node[V, T], config TreeConfig) ([]byte, error) {
+	var b bytes.Buffer
+	if err := encodeBinary(&b, kind, root, config); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// encodeBinary writes the binary format of the tree rooted at root to w, one entry at a time.
+func encodeBinary[V, T any](w io.Writer, kind byte, root *node[V, T], config TreeConfig) error {
+	crc := crc32.NewIEEE()
+	cw := io.MultiWriter(w, crc)
+
+	var flags byte
+	if config.allowIntervalPoint {
+		flags |= binaryFlagIntervalPoint
+	}
+
+	header := append(binaryMagic[:], binaryVersion, kind, flags, byte(config.bounds))
+	if _, err := cw.Write(header); err != nil {
+		return err
+	}
+
+	enc := gob.NewEncoder(cw)
+	if err := enc.Encode(size(root)); err != nil {
+		return err
+	}
+
+	var err error
+	inOrder(root, 0, func(_ int, it interval[V, T]) bool {
+		err = enc.Encode(binaryEntry[V, T]{
+			Start:     it.Start,
+			End:       it.End,
+			Bounds:    it.Bounds,
+			Val:       it.Val,
+			Vals:      it.Vals,
+			ExpiresAt: it.ExpiresAt,
+		})
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32()))
+	return err
+}
+
+func unmarshalBinary[V, T any](data []byte, wantKind byte, wantTypeName string, root **node[V, T], config *TreeConfig, cmp CmpFunc[T]) error {
+	if len(data) < binaryHeaderSize+binaryCRCSize {
+		return newInvalidEncodingError("got %d bytes; want at least %d", len(data), binaryHeaderSize+binaryCRCSize)
+	}
+
+	// As the whole data is at hand, the checksum is verified before decoding anything.
+	body, sum := data[:len(data)-binaryCRCSize], data[len(data)-binaryCRCSize:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(sum) {
+		return newInvalidEncodingError("checksum mismatch")
+	}
+
+	n, cfg, err := decodeBinary[V, T](newChecksumReader(bytes.NewReader(data)), wantKind, wantTypeName, *config, cmp)
+	if err != nil {
+		return err
+	}
+
+	*root, *config = n, cfg
+
+	return nil
+}
+
+// decodeBinary reads the binary format of a tree from r, and returns the root of the tree
+// built in linear time with the decoded entries, along with the given config with the decoded flags.
+// The checksum is verified once all the entries are decoded, so r is read only once.
+func decodeBinary[V, T any](r *checksumReader, wantKind byte, wantTypeName string, config TreeConfig, cmp CmpFunc[T]) (*node[V, T], TreeConfig, error) {
+	header := make([]byte, binaryHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, config, newInvalidEncodingError("cannot read header: %v", err)
+	}
+
+	if !bytes.HasPrefix(header, binaryMagic[:]) {
+		return nil, config, newInvalidEncodingError("unknown magic %q", header[:len(binaryMagic)])
+	}
+
+	cfg, err := decodeBinaryHeader(header, wantKind, wantTypeName, config)
+	if err != nil {
+		return nil, config, err
+	}
+
+	if cmp == nil {
+		return nil, config, errNilCmp
+	}
+
+	root, err := decodeBinaryEntries[V, T](gob.NewDecoder(r), cfg, cmp, wantKind == binaryKindMultiValueSearchTree)
+	if err != nil {
+		return nil, config, err
+	}
+
+	want := r.crc.Sum32()
+
+	sum := make([]byte, binaryCRCSize)
+	if _, err := io.ReadFull(r, sum); err != nil {
+		return nil, config, newInvalidEncodingError("cannot read checksum: %v", err)
+	}
+
+	if binary.BigEndian.Uint32(sum) != want {
+		return nil, config, newInvalidEncodingError("checksum mismatch")
+	}
+
+	return root, cfg, nil
+}
+
+// decodeBinaryHeader checks the given header and returns the given config with the flags it holds.
+func decodeBinaryHeader(header []byte, wantKind byte, wantTypeName string, config TreeConfig) (TreeConfig, error) {
+	version, kind, flags, bounds := header[4], header[5], header[6], Bounds(header[7])
+
+	if version != binaryVersion {
+		return config, newInvalidEncodingError("unsupported version %d", version)
+	}
+
+	if kind != wantKind {
+		return config, TypeMismatchError{from: binaryKindName(kind), to: wantTypeName}
+	}
+
+	if bounds > Open {
+		return config, newInvalidEncodingError("unknown bounds %d", bounds)
+	}
+
+	config.allowIntervalPoint = flags&binaryFlagIntervalPoint != 0
+	config.bounds = bounds
+
+	return config, nil
+}
+
+func binaryKindName(kind byte) string {
+	switch kind {
+	case binaryKindSearchTree:
+		return "SearchTree"
+	case binaryKindMultiValueSearchTree:
+		return "MultiValueSearchTree"
+	default:
+		return fmt.Sprintf("kind %d", kind)
+	}
+}
+
+// binaryDecodingError wraps the errors found while decoding entries, so that they can be told apart
+// from any other panic while building the tree.
+type binaryDecodingError struct {
+	err error
+}
+
+// decodeBinaryEntries decodes the payload of the binary format with dec, and returns the root of a tree
+// built in linear time with the decoded entries, which are validated along the way.
+func decodeBinaryEntries[V, T any](dec *gob.Decoder, config TreeConfig, cmp CmpFunc[T], multi bool) (root *node[V, T], err error) {
+	var n int
+	if err := dec.Decode(&n); err != nil {
+		return nil, newInvalidEncodingError("cannot decode the number of entries: %v", err)
+	}
+
+	if n < 0 {
+		return nil, newInvalidEncodingError("negative number of entries %d", n)
+	}
+
+	// As buildFromSorted can't return errors, next panics to stop building the tree.
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(binaryDecodingError)
+			if !ok {
+				panic(r)
+			}
+			root, err = nil, e.err
+		}
+	}()
+
+	var prev *interval[V, T]
+	next := func() interval[V, T] {
+		var e binaryEntry[V, T]
+		if err := dec.Decode(&e); err != nil {
+			panic(binaryDecodingError{newInvalidEncodingError("cannot decode entry: %v", err)})
+		}
+
+		it := interval[V, T]{
+			Start:      e.Start,
+			End:        e.End,
+			Val:        e.Val,
+			Vals:       e.Vals,
+			AllowPoint: config.allowIntervalPoint,
+			Bounds:     e.Bounds,
+			ExpiresAt:  e.ExpiresAt,
+		}
+
+		if err := validateBinaryEntry(it, prev, cmp, multi); err != nil {
+			panic(binaryDecodingError{err})
+		}
+
+		prev = &it
+		return it
+	}
+
+	return buildFromSorted(n, next, cmp), nil
+}
+
+func validateBinaryEntry[V, T any](it interval[V, T], prev *interval[V, T], cmp CmpFunc[T], multi bool) error {
+	if it.isInvalid(cmp) {
+		return newInvalidIntervalError(it)
+	}
+
+	if it.Bounds > Open {
+		return newInvalidEncodingError("unknown bounds %d", it.Bounds)
+	}
+
+	if multi && len(it.Vals) == 0 {
+		return newEmptyValueListError(it, "decode")
+	}
+
+	if prev != nil && !prev.less(it.Start, it.End, cmp) {
+		return newInvalidEncodingError("entry (%v, %v) is not after entry (%v, %v)", it.Start, it.End, prev.Start, prev.End)
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package interval
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"hash/crc32"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchTree_Binary_RoundTrip(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	st1 := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithIntervalPoint(), TreeWithBounds(HalfOpen), TreeWithClock(func() time.Time { return now }))
+	for i := 0; i < 1000; i++ {
+		st1.Insert(i, i+i%7, "node")
+	}
+	st1.InsertWithBounds(3, 50, Closed, "node1")
+	st1.InsertWithTTL(4, 60, "node2", time.Hour)
+
+	data, err := st1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	if err := st2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+	}
+
+	if st2.config.allowIntervalPoint != true || st2.config.bounds != HalfOpen {
+		t.Errorf("st.UnmarshalBinary: got unexpected config %+v", st2.config)
+	}
+}
+
+func TestSearchTree_Binary_Empty(t *testing.T) {
+	st1 := NewSearchTree[string](func(x, y int) int { return x - y })
+
+	data, err := st1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	st2.Insert(1, 5, "node1")
+
+	if err := st2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	if !st2.IsEmpty() {
+		t.Errorf("st.UnmarshalBinary: got %d entries; want an empty tree", st2.Size())
+	}
+}
+
+func TestSearchTree_UnmarshalBinary_Legacy(t *testing.T) {
+	st1 := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(Open))
+	st1.Insert(17, 19, "node1")
+	st1.Insert(5, 8, "node2")
+
+	data, err := st1.GobEncode()
+	if err != nil {
+		t.Fatalf("st.GobEncode: got unexpected error %v", err)
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	if err := st2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+	}
+
+	if st2.config.bounds != Open {
+		t.Errorf("st.UnmarshalBinary: got unexpected bounds %v; want %v", st2.config.bounds, Open)
+	}
+}
+
+func TestSearchTree_UnmarshalBinary_LegacyRebuilt(t *testing.T) {
+	// The decoded nodes have a wrong size, max end and color, which are recomputed.
+	root := &node[string, int]{
+		Interval: interval[string, int]{Start: 5, End: 8, Val: "node2"},
+		MaxEnd:   8,
+		Color:    red,
+		Size:     7,
+		Left:     &node[string, int]{Interval: interval[string, int]{Start: 1, End: 9, Val: "node1"}, MaxEnd: 1, Size: 1},
+		Right:    &node[string, int]{Interval: interval[string, int]{Start: 17, End: 19, Val: "node3"}, MaxEnd: 19},
+	}
+
+	data, err := gobEncode("SearchTree", root, TreeConfig{})
+	if err != nil {
+		t.Fatalf("gobEncode: got unexpected error %v", err)
+	}
+
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	if err := st.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st.root)
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 9, Val: "node1"},
+		{Start: 5, End: 8, Val: "node2"},
+		{Start: 17, End: 19, Val: "node3"},
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_UnmarshalBinary_LegacyError(t *testing.T) {
+	legacy := func(root *node[string, int]) []byte {
+		data, err := gobEncode("SearchTree", root, TreeConfig{})
+		if err != nil {
+			t.Fatalf("gobEncode: got unexpected error %v", err)
+		}
+		return data
+	}
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{
+			name:    "Garbage",
+			data:    []byte{0x7f, 0x01, 0x02, 0x03},
+			wantErr: InvalidEncodingError(""),
+		},
+		{
+			name: "Unsorted",
+			data: legacy(&node[string, int]{
+				Interval: interval[string, int]{Start: 1, End: 5, Val: "node1"},
+				Left:     &node[string, int]{Interval: interval[string, int]{Start: 5, End: 9, Val: "node2"}},
+			}),
+			wantErr: InvalidEncodingError(""),
+		},
+		{
+			name:    "InvalidInterval",
+			data:    legacy(&node[string, int]{Interval: interval[string, int]{Start: 5, End: 1, Val: "node1"}}),
+			wantErr: InvalidIntervalError(""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := NewSearchTree[string](func(x, y int) int { return x - y })
+			st.Insert(2, 3, "node3")
+
+			err := st.UnmarshalBinary(tc.data)
+			if reflect.TypeOf(err) != reflect.TypeOf(tc.wantErr) {
+				t.Fatalf("st.UnmarshalBinary: got error %v of type %T; want it to be %T", err, err, tc.wantErr)
+			}
+
+			if got, want := entriesOf(st), []Entry[string, int]{{Start: 2, End: 3, Val: "node3"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_UnmarshalBinary_Error(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	st.Insert(1, 5, "node1")
+	st.Insert(5, 9, "node2")
+
+	data, err := st.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		data func() []byte
+	}{
+		{
+			name: "Truncated",
+			data: func() []byte { return data[:len(data)-1] },
+		},
+		{
+			name: "Corrupted",
+			data: func() []byte {
+				b := bytes.Clone(data)
+				b[len(b)/2] ^= 0xff
+				return b
+			},
+		},
+		{
+			name: "UnsupportedVersion",
+			data: func() []byte {
+				b := bytes.Clone(data[:len(data)-binaryCRCSize])
+				b[4] = binaryVersion + 1
+				return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+			},
+		},
+		{
+			name: "Unsorted",
+			data: func() []byte {
+				return mustEncodeBinaryEntries(t, binaryKindSearchTree, []binaryEntry[string, int]{
+					{Start: 5, End: 9, Val: "node2"},
+					{Start: 1, End: 5, Val: "node1"},
+				})
+			},
+		},
+		{
+			name: "Missing",
+			data: func() []byte {
+				// The payload claims there are more entries than encoded.
+				var buf bytes.Buffer
+				buf.Write(data[:binaryHeaderSize])
+				enc := gob.NewEncoder(&buf)
+				enc.Encode(2)
+				enc.Encode(binaryEntry[string, int]{Start: 1, End: 5, Val: "node1"})
+				return binary.BigEndian.AppendUint32(buf.Bytes(), crc32.ChecksumIEEE(buf.Bytes()))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+			st2.Insert(2, 3, "node3")
+
+			err := st2.UnmarshalBinary(tc.data())
+
+			var wantErr InvalidEncodingError
+			if !errors.As(err, &wantErr) {
+				t.Fatalf("st.UnmarshalBinary: got error %v of type %T; want it to be %T", err, err, wantErr)
+			}
+
+			if got, want := entriesOf(st2), []Entry[string, int]{{Start: 2, End: 3, Val: "node3"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("InvalidInterval", func(t *testing.T) {
+		data := mustEncodeBinaryEntries(t, binaryKindSearchTree, []binaryEntry[string, int]{{Start: 5, End: 1, Val: "node1"}})
+
+		err := NewSearchTree[string](func(x, y int) int { return x - y }).UnmarshalBinary(data)
+
+		var wantErr InvalidIntervalError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("st.UnmarshalBinary: got error type %T; want it to be %T", err, wantErr)
+		}
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		mv := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+		mv.Insert(1, 5, "node1")
+
+		data, err := mv.MarshalBinary()
+		if err != nil {
+			t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+		}
+
+		err = NewSearchTree[string](func(x, y int) int { return x - y }).UnmarshalBinary(data)
+		wantErr := TypeMismatchError{from: "MultiValueSearchTree", to: "SearchTree"}
+		if !errors.Is(err, wantErr) {
+			t.Errorf("st.UnmarshalBinary: got unexpected error %v; want %v", err, wantErr)
+		}
+	})
+}
+
+func TestMultiValueSearchTree_Binary_RoundTrip(t *testing.T) {
+	st1 := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(Open))
+	for i := 0; i < 100; i++ {
+		st1.Insert(i, i+10, "node1", "node2")
+	}
+	st1.InsertWithBounds(3, 50, Closed, "node3")
+
+	data, err := st1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	st2 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	if err := st2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf((*SearchTree[string, int])(st2)), entriesOf((*SearchTree[string, int])(st1)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_UnmarshalBinary_Legacy(t *testing.T) {
+	st1 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	st1.Insert(17, 19, "node1", "node2")
+	st1.Insert(5, 8, "node3")
+
+	data, err := st1.GobEncode()
+	if err != nil {
+		t.Fatalf("st.GobEncode: got unexpected error %v", err)
+	}
+
+	st2 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	if err := st2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("st.UnmarshalBinary: got unexpected error %v", err)
+	}
+
+	if got, want := entriesOf((*SearchTree[string, int])(st2)), entriesOf((*SearchTree[string, int])(st1)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.UnmarshalBinary: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_UnmarshalBinary_EmptyValueList(t *testing.T) {
+	data := mustEncodeBinaryEntries(t, binaryKindMultiValueSearchTree, []binaryEntry[string, int]{{Start: 1, End: 5}})
+
+	err := NewMultiValueSearchTree[string](func(x, y int) int { return x - y }).UnmarshalBinary(data)
+
+	var wantErr EmptyValueListError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.UnmarshalBinary: got error type %T; want it to be %T", err, wantErr)
+	}
+}
+
+// mustEncodeBinaryEntries encodes the given entries in the binary format, as they are, with a default config.
+func mustEncodeBinaryEntries[V, T any](t *testing.T, kind byte, entries []binaryEntry[V, T]) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	b.Write(binaryMagic[:])
+	b.Write([]byte{binaryVersion, kind, 0, byte(Closed)})
+
+	enc := gob.NewEncoder(&b)
+	if err := enc.Encode(len(entries)); err != nil {
+		t.Fatalf("Encode: got unexpected error %v", err)
+	}
+
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			t.Fatalf("Encode: got unexpected error %v", err)
+		}
+	}
+
+	return binary.BigEndian.AppendUint32(b.Bytes(), crc32.ChecksumIEEE(b.Bytes()))
+}
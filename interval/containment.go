@@ -0,0 +1,128 @@
+package interval
+
+// ContainedIn returns a slice of values which interval key is entirely contained in the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *SearchTree[V, T]) ContainedIn(start, end T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().ContainedIn(start, end)
+}
+
+// ContainedIn returns a slice of values which interval key is entirely contained in the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *SearchTreeView[V, T]) ContainedIn(start, end T) ([]V, bool) {
+	var vals []V
+	q := interval[V, T]{Start: start, End: end, Bounds: st.config.bounds}
+	live := st.live()
+	containedIn(st.root, q, st.cmp, func(it interval[V, T]) {
+		if live(it) {
+			vals = append(vals, it.Val)
+		}
+	})
+
+	return vals, len(vals) > 0
+}
+
+// Containing returns a slice of values which interval key entirely contains the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *SearchTree[V, T]) Containing(start, end T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Containing(start, end)
+}
+
+// Containing returns a slice of values which interval key entirely contains the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *SearchTreeView[V, T]) Containing(start, end T) ([]V, bool) {
+	var vals []V
+	q := interval[V, T]{Start: start, End: end, Bounds: st.config.bounds}
+	live := st.live()
+	containing(st.root, q, st.cmp, func(it interval[V, T]) {
+		if live(it) {
+			vals = append(vals, it.Val)
+		}
+	})
+
+	return vals, len(vals) > 0
+}
+
+// ContainedIn returns a slice of values which interval key is entirely contained in the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) ContainedIn(start, end T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().ContainedIn(start, end)
+}
+
+// ContainedIn returns a slice of values which interval key is entirely contained in the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) ContainedIn(start, end T) ([]V, bool) {
+	var vals []V
+	q := interval[V, T]{Start: start, End: end, Bounds: st.config.bounds}
+	containedIn(st.root, q, st.cmp, func(it interval[V, T]) {
+		vals = append(vals, it.Vals...)
+	})
+
+	return vals, len(vals) > 0
+}
+
+// Containing returns a slice of values which interval key entirely contains the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) Containing(start, end T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Containing(start, end)
+}
+
+// Containing returns a slice of values which interval key entirely contains the given start and end interval.
+// It returns true as the second return value if any interval is found; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) Containing(start, end T) ([]V, bool) {
+	var vals []V
+	q := interval[V, T]{Start: start, End: end, Bounds: st.config.bounds}
+	containing(st.root, q, st.cmp, func(it interval[V, T]) {
+		vals = append(vals, it.Vals...)
+	})
+
+	return vals, len(vals) > 0
+}
+
+func containedIn[V, T any](n *node[V, T], q interval[V, T], cmp CmpFunc[T], foundFn func(interval[V, T])) {
+	if n == nil || cmp.lt(n.MaxEnd, q.Start) {
+		return
+	}
+
+	// Intervals in the left subtree can't start after this one.
+	if cmp.gte(n.Interval.Start, q.Start) {
+		containedIn(n.Left, q, cmp, foundFn)
+	}
+
+	if q.contains(n.Interval, cmp) {
+		foundFn(n.Interval)
+	}
+
+	// Intervals in the right subtree can't start before this one.
+	if cmp.lte(n.Interval.Start, q.End) {
+		containedIn(n.Right, q, cmp, foundFn)
+	}
+}
+
+func containing[V, T any](n *node[V, T], q interval[V, T], cmp CmpFunc[T], foundFn func(interval[V, T])) {
+	if n == nil || cmp.lt(n.MaxEnd, q.End) {
+		return
+	}
+
+	containing(n.Left, q, cmp, foundFn)
+
+	if n.Interval.contains(q, cmp) {
+		foundFn(n.Interval)
+	}
+
+	// Intervals in the right subtree can't start before this one.
+	if cmp.lte(n.Interval.Start, q.Start) {
+		containing(n.Right, q, cmp, foundFn)
+	}
+}
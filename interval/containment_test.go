@@ -0,0 +1,149 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_ContainedIn(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+	st.InsertWithBounds(10, 15, Open, "node7")
+
+	testCases := []struct {
+		start    int
+		end      int
+		wantVals []string
+	}{
+		{start: 5, end: 18, wantVals: []string{"node2", "node6", "node7", "node5"}},
+		{start: 4, end: 8, wantVals: []string{"node4", "node2"}},
+		{start: 16, end: 20, wantVals: []string{"node1"}},
+		{start: 11, end: 14},
+		{start: 0, end: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			got, ok := st.ContainedIn(tc.start, tc.end)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.ContainedIn(%v, %v): got ok value %t; want %t", tc.start, tc.end, ok, want)
+			}
+
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.ContainedIn(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.wantVals)
+			}
+		})
+	}
+}
+
+func TestSearchTree_Containing(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+	st.InsertWithBounds(10, 15, Open, "node7")
+
+	testCases := []struct {
+		start    int
+		end      int
+		wantVals []string
+	}{
+		{start: 6, end: 7, wantVals: []string{"node4", "node2"}},
+		{start: 17, end: 18, wantVals: []string{"node5", "node1"}},
+		{start: 11, end: 14, wantVals: []string{"node7"}},
+		{start: 10, end: 14},
+		{start: 4, end: 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			got, ok := st.Containing(tc.start, tc.end)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.Containing(%v, %v): got ok value %t; want %t", tc.start, tc.end, ok, want)
+			}
+
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.Containing(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.wantVals)
+			}
+		})
+	}
+}
+
+func TestSearchTree_Containment_Random(t *testing.T) {
+	cmp := CmpFunc[int](func(x, y int) int { return x - y })
+	st := NewSearchTreeWithOptions[int](cmp, TreeWithBounds(HalfOpen))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		start := r.Intn(100)
+		st.InsertWithBounds(start, start+1+r.Intn(20), Bounds(r.Intn(4)), i)
+	}
+
+	for i := 0; i < 200; i++ {
+		start := r.Intn(100)
+		end := start + 1 + r.Intn(30)
+		q := interval[int, int]{Start: start, End: end, Bounds: HalfOpen}
+
+		var wantContainedIn, wantContaining []int
+		for _, e := range st.All() {
+			it := interval[int, int]{Start: e.Start, End: e.End, Bounds: e.Bounds}
+			if q.contains(it, cmp) {
+				wantContainedIn = append(wantContainedIn, e.Val)
+			}
+			if it.contains(q, cmp) {
+				wantContaining = append(wantContaining, e.Val)
+			}
+		}
+
+		if got, _ := st.ContainedIn(start, end); !reflect.DeepEqual(got, wantContainedIn) {
+			t.Fatalf("st.ContainedIn(%v, %v): got unexpected value %v; want %v", start, end, got, wantContainedIn)
+		}
+
+		if got, _ := st.Containing(start, end); !reflect.DeepEqual(got, wantContaining) {
+			t.Fatalf("st.Containing(%v, %v): got unexpected value %v; want %v", start, end, got, wantContaining)
+		}
+	}
+}
+
+func TestMultiValueSearchTree_Containment(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1", "node2")
+	st.Insert(5, 9, "node3")
+	st.Insert(3, 6, "node4")
+
+	got, ok := st.ContainedIn(1, 6)
+	if want := []string{"node1", "node2", "node4"}; !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ContainedIn(1, 6): got unexpected value %v; want %v", got, want)
+	}
+
+	got, ok = st.Containing(5, 6)
+	if want := []string{"node4", "node3"}; !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Containing(5, 6): got unexpected value %v; want %v", got, want)
+	}
+
+	// Both [8, 9) and [5, 9) exclude 9.
+	got, ok = st.Containing(8, 9)
+	if want := []string{"node3"}; !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Containing(8, 9): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, ok := st.ContainedIn(10, 20); ok {
+		t.Errorf("st.ContainedIn(10, 20): got unexpected value %v", got)
+	}
+}
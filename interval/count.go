@@ -0,0 +1,154 @@
+package interval
+
+// CountIntersections returns the number of intervals in the tree that intersect with the given start and end interval.
+//
+// CountIntersections relies on the size of the subtrees to count the intervals starting strictly within
+// the given interval in O(log n) time, as they always intersect with it. However, the intervals starting at either
+// endpoint or before the given interval are checked one by one, skipping only the subtrees which intervals all end
+// before it, so CountIntersections runs in O((k+1) log n) time, where k is the number of intervals
+// starting at the given end, or starting at or before the given start and ending at or after it.
+//
+// The expired entries are then subtracted, by visiting only the subtrees holding entries inserted with InsertWithTTL.
+func (st *SearchTree[V, T]) CountIntersections(start, end T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CountIntersections(start, end)
+}
+
+// CountIntersections returns the number of intervals in the tree that intersect with the given start and end interval.
+//
+// CountIntersections relies on the size of the subtrees to count the intervals starting strictly within
+// the given interval in O(log n) time, as they always intersect with it. However, the intervals starting at either
+// endpoint or before the given interval are checked one by one, skipping only the subtrees which intervals all end
+// before it, so CountIntersections runs in O((k+1) log n) time, where k is the number of intervals
+// starting at the given end, or starting at or before the given start and ending at or after it.
+//
+// The expired entries are then subtracted, by visiting only the subtrees holding entries inserted with InsertWithTTL.
+func (st *SearchTreeView[V, T]) CountIntersections(start, end T) int {
+	count := countIntersections(st.root, start, end, st.config.bounds, st.cmp)
+
+	q := interval[V, T]{Start: start, End: end, Bounds: st.config.bounds}
+	return count - countExpired(st.root, q, st.live(), st.cmp)
+}
+
+// CountIntersections returns the number of intervals in the tree that intersect with the given start and end interval,
+// regardless of how many values are stored for each of them. To count the values, see CountIntersectionValues.
+//
+// CountIntersections relies on the size of the subtrees to count the intervals starting strictly within
+// the given interval in O(log n) time, as they always intersect with it. However, the intervals starting at either
+// endpoint or before the given interval are checked one by one, skipping only the subtrees which intervals all end
+// before it, so CountIntersections runs in O((k+1) log n) time, where k is the number of intervals
+// starting at the given end, or starting at or before the given start and ending at or after it.
+func (st *MultiValueSearchTree[V, T]) CountIntersections(start, end T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CountIntersections(start, end)
+}
+
+// CountIntersections returns the number of intervals in the tree that intersect with the given start and end interval,
+// regardless of how many values are stored for each of them. To count the values, see CountIntersectionValues.
+//
+// CountIntersections relies on the size of the subtrees to count the intervals starting strictly within
+// the given interval in O(log n) time, as they always intersect with it. However, the intervals starting at either
+// endpoint or before the given interval are checked one by one, skipping only the subtrees which intervals all end
+// before it, so CountIntersections runs in O((k+1) log n) time, where k is the number of intervals
+// starting at the given end, or starting at or before the given start and ending at or after it.
+func (st *MultiValueSearchTreeView[V, T]) CountIntersections(start, end T) int {
+	return countIntersections(st.root, start, end, st.config.bounds, st.cmp)
+}
+
+// CountIntersectionValues returns the total number of values stored for the intervals in the tree
+// that intersect with the given start and end interval, without allocating them in a slice.
+func (st *MultiValueSearchTree[V, T]) CountIntersectionValues(start, end T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CountIntersectionValues(start, end)
+}
+
+// CountIntersectionValues returns the total number of values stored for the intervals in the tree
+// that intersect with the given start and end interval, without allocating them in a slice.
+func (st *MultiValueSearchTreeView[V, T]) CountIntersectionValues(start, end T) int {
+	var count int
+	if st.root == nil {
+		return count
+	}
+
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, func(_ int, it interval[V, T]) bool {
+		count += len(it.Vals)
+		return true
+	})
+
+	return count
+}
+
+func countIntersections[V, T any](root *node[V, T], start, end T, b Bounds, cmp CmpFunc[T]) int {
+	q := interval[V, T]{Start: start, End: end, Bounds: b}
+
+	count := countStartingUpTo(root, q, cmp)
+	if cmp.lt(start, end) {
+		count += countStartsBefore(root, end, false, cmp) - countStartsBefore(root, start, true, cmp)
+		count += countStartingAt(root, q, cmp)
+	}
+
+	return count
+}
+
+// countStartsBefore returns the number of intervals which start is less than x,
+// or less than or equal to x if inclusive is true.
+func countStartsBefore[V, T any](root *node[V, T], x T, inclusive bool, cmp CmpFunc[T]) int {
+	var count int
+
+	cur := root
+	for cur != nil {
+		if c := cmp(cur.Interval.Start, x); c < 0 || inclusive && c == 0 {
+			count += 1 + size(cur.Left)
+			cur = cur.Right
+		} else {
+			cur = cur.Left
+		}
+	}
+
+	return count
+}
+
+// countStartingUpTo returns the number of intervals starting at or before the start of q that intersect with q.
+// It visits every interval starting at or before the start of q and ending at or after it, along with their ancestors.
+func countStartingUpTo[V, T any](n *node[V, T], q interval[V, T], cmp CmpFunc[T]) int {
+	if n == nil || cmp.lt(n.MaxEnd, q.Start) {
+		return 0
+	}
+
+	count := countStartingUpTo(n.Left, q, cmp)
+	if cmp.lte(n.Interval.Start, q.Start) {
+		if n.Interval.intersects(q.Start, q.End, q.Bounds, cmp) {
+			count++
+		}
+		count += countStartingUpTo(n.Right, q, cmp)
+	}
+
+	return count
+}
+
+// countStartingAt returns the number of intervals starting at the end of q that intersect with q.
+func countStartingAt[V, T any](n *node[V, T], q interval[V, T], cmp CmpFunc[T]) int {
+	if n == nil {
+		return 0
+	}
+
+	switch c := cmp(n.Interval.Start, q.End); {
+	case c < 0:
+		return countStartingAt(n.Right, q, cmp)
+	case c > 0:
+		return countStartingAt(n.Left, q, cmp)
+	}
+
+	count := countStartingAt(n.Left, q, cmp) + countStartingAt(n.Right, q, cmp)
+	if n.Interval.intersects(q.Start, q.End, q.Bounds, cmp) {
+		count++
+	}
+
+	return count
+}
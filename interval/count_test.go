@@ -0,0 +1,123 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSearchTree_CountIntersections(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+	st.Insert(16, 22, "node7")
+
+	testCases := []struct {
+		start int
+		end   int
+		want  int
+	}{
+		{start: 9, end: 16, want: 3},
+		{start: 12, end: 14, want: 0},
+		{start: 0, end: 30, want: 7},
+		{start: 8, end: 8, want: 3},
+		{start: 21, end: 23, want: 2},
+		{start: 30, end: 20, want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			if got := st.CountIntersections(tc.start, tc.end); got != tc.want {
+				t.Errorf("st.CountIntersections(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_CountIntersections_Random(t *testing.T) {
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		t.Run(b.String(), func(t *testing.T) {
+			st := NewSearchTreeWithOptions[int](func(x, y int) int { return x - y }, TreeWithBounds(b), TreeWithIntervalPoint())
+
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 1000; i++ {
+				start := r.Intn(200)
+				st.InsertWithBounds(start, start+r.Intn(30), Bounds(r.Intn(4)), i)
+			}
+			mustBeValidTree(t, st.root)
+
+			for i := 0; i < 500; i++ {
+				start := r.Intn(200)
+				end := start + r.Intn(40)
+
+				vals, _ := st.AllIntersections(start, end)
+				if got, want := st.CountIntersections(start, end), len(vals); got != want {
+					t.Fatalf("st.CountIntersections(%v, %v): got unexpected value %v; want %v", start, end, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchTree_CountIntersections_Allocs(t *testing.T) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+	for i := 0; i < 100; i++ {
+		st.Insert(i, i+10, i)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		st.CountIntersections(20, 60)
+	})
+
+	if allocs != 0 {
+		t.Errorf("st.CountIntersections(20, 60): got %v allocations; want 0", allocs)
+	}
+}
+
+func TestMultiValueSearchTree_CountIntersections(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1", "node2")
+	st.Insert(5, 9, "node3")
+	st.Insert(3, 6, "node4", "node5", "node6")
+	st.Insert(10, 12, "node7")
+
+	if got, want := st.CountIntersections(4, 5), 3; got != want {
+		t.Errorf("st.CountIntersections(4, 5): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, want := st.CountIntersectionValues(4, 5), 6; got != want {
+		t.Errorf("st.CountIntersectionValues(4, 5): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, want := st.CountIntersectionValues(13, 15), 0; got != want {
+		t.Errorf("st.CountIntersectionValues(13, 15): got unexpected value %v; want %v", got, want)
+	}
+}
+
+func BenchmarkSearchTree_CountIntersections(b *testing.B) {
+	st := NewSearchTree[int](func(x, y int64) int { return int(x - y) })
+	for i := int64(0); i < 1_000_000; i++ {
+		st.Insert(i, i+100, int(i))
+	}
+
+	b.Run("CountIntersections", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result = st.CountIntersections(1_000, 900_000)
+		}
+	})
+
+	b.Run("AllIntersections", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vals, _ := st.AllIntersections(1_000, 900_000)
+			result = len(vals)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package interval
+
+// Coverage returns the length of the parts of the range from the given start to the given end
+// which are covered by at least one interval in the given tree, measured with the given dist function.
+// The bounds of the range are the ones configured for the tree.
+//
+// Coverage returns 0 if the given end is less than the given start value,
+// or equal to it and the tree isn't configured with TreeWithIntervalPoint.
+func Coverage[V, T any, D Length](st Tree[V, T], start, end T, dist DistanceFunc[T, D]) D {
+	var covered D
+	st.read(func(st *SearchTreeView[V, T]) {
+		covered, _, _ = coverage(st.root, start, end, st.config, dist, st.live(), st.cmp)
+	})
+
+	return covered
+}
+
+// CoverageRatio returns the ratio of the length of the range from the given start to the given end
+// which is covered by at least one interval in the given tree, as a number from 0 to 1. For more details, see Coverage.
+//
+// The ratio of a range with zero length is 1 if it's entirely covered, or 0 otherwise.
+func CoverageRatio[V, T any, D Length](st Tree[V, T], start, end T, dist DistanceFunc[T, D]) float64 {
+	var (
+		covered, total D
+		full           bool
+	)
+	st.read(func(st *SearchTreeView[V, T]) {
+		covered, total, full = coverage(st.root, start, end, st.config, dist, st.live(), st.cmp)
+	})
+
+	switch {
+	case total > 0:
+		return float64(covered) / float64(total)
+	case full:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// coverage returns the covered length of the range from the given start to the given end, measured with dist, along with the length
+// of the range, by subtracting the length of its gaps, ignoring the intervals for which live returns false.
+// It also reports whether the range is valid and has no gaps.
+func coverage[V, T any, D Length](root *node[V, T], start, end T, config TreeConfig, dist DistanceFunc[T, D], live func(interval[V, T]) bool, cmp CmpFunc[T]) (covered, total D, full bool) {
+	w, err := newRangeInterval[V](start, end, config, cmp)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	total, full = dist(start, end), true
+
+	var uncovered D
+	gaps(root, w, live, cmp, func(r Range[T]) bool {
+		uncovered += dist(r.Start, r.End)
+		full = false
+		return true
+	})
+
+	// Guard against rounding errors of the distance function, and against wrapping around unsigned lengths.
+	if uncovered >= total {
+		return 0, total, full
+	}
+
+	return total - uncovered, total, full
+}
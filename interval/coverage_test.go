@@ -0,0 +1,104 @@
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSearchTree_Coverage(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithBounds(HalfOpen),
+		TreeWithIntervalPoint(),
+	)
+
+	st.Insert(9, 10, "job1")
+	st.Insert(11, 13, "job2")
+	st.Insert(12, 14, "job3")
+	st.Insert(16, 17, "job4")
+
+	testCases := []struct {
+		start, end int
+		want       int
+		wantRatio  float64
+	}{
+		{start: 8, end: 18, want: 5, wantRatio: 0.5},
+		{start: 11, end: 14, want: 3, wantRatio: 1},
+		{start: 12, end: 16, want: 2, wantRatio: 0.5},
+		{start: 0, end: 5, want: 0, wantRatio: 0},
+		{start: 12, end: 12, want: 0, wantRatio: 1},
+		{start: 15, end: 15, want: 0, wantRatio: 0},
+		{start: 16, end: 10, want: 0, wantRatio: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			if got := Coverage(st, tc.start, tc.end, intDistance); got != tc.want {
+				t.Errorf("Coverage(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.want)
+			}
+
+			if got := CoverageRatio(st, tc.start, tc.end, intDistance); math.Abs(got-tc.wantRatio) > 1e-9 {
+				t.Errorf("CoverageRatio(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.wantRatio)
+			}
+		})
+	}
+}
+
+func TestMultiValueSearchTree_Coverage(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y float64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	st.Insert(0.5, 1.5, "node1", "node2")
+	st.Insert(1, 2, "node3")
+
+	dist := func(x, y float64) float64 { return y - x }
+
+	if got, want := Coverage(st, 0, 4, dist), 1.5; got != want {
+		t.Errorf("Coverage(0, 4): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, want := CoverageRatio(st, 0, 4, dist), 0.375; got != want {
+		t.Errorf("CoverageRatio(0, 4): got unexpected value %v; want %v", got, want)
+	}
+}
+
+func TestCoverage_IntegerLength(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int64) int { return cmp.Compare(x, y) })
+
+	st.Insert(0, 1, "job1")
+
+	// The length of the range isn't representable as a float64.
+	dist := func(x, y int64) int64 { return y - x }
+	if got, want := Coverage(st, 0, 1<<60+1, dist), int64(1); got != want {
+		t.Errorf("Coverage(0, 1<<60+1): got unexpected value %v; want %v", got, want)
+	}
+}
+
+func TestCoverage_Duration(t *testing.T) {
+	st := NewSearchTree[string](func(x, y time.Time) int { return x.Compare(y) })
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	st.Insert(start, start.Add(time.Hour), "job1")
+	st.Insert(start.Add(90*time.Minute), start.Add(2*time.Hour), "job2")
+
+	dist := func(x, y time.Time) time.Duration { return y.Sub(x) }
+
+	view := st.Snapshot()
+	if got, want := Coverage(view, start, start.Add(3*time.Hour), dist), 90*time.Minute; got != want {
+		t.Errorf("Coverage(view, 0h, 3h): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, want := CoverageRatio(view, start, start.Add(3*time.Hour), dist), 0.5; got != want {
+		t.Errorf("CoverageRatio(view, 0h, 3h): got unexpected value %v; want %v", got, want)
+	}
+}
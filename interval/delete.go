@@ -1,5 +1,7 @@
 package interval
 
+import "slices"
+
 // Delete removes the given start and end interval key and its associated value from the tree.
 // It does nothing if the given start and end interval key doesn't exist in the tree.
 //
@@ -35,6 +37,8 @@ func delete[V, T any](n *node[V, T], intervl interval[V, T], cmp CmpFunc[T]) *no
 		return nil
 	}
 
+	n = mutable(n)
+
 	if intervl.less(n.Interval.Start, n.Interval.End, cmp) {
 		if n.Left != nil && !isRed(n.Left) && !isRed(n.Left.Left) {
 			n = moveRedLeft(n, cmp)
@@ -53,6 +57,8 @@ func delete[V, T any](n *node[V, T], intervl interval[V, T], cmp CmpFunc[T]) *no
 		if n.Interval.equal(intervl.Start, intervl.End, cmp) {
 			minNode := min(n.Right)
 			n.Interval = minNode.Interval
+			// minNode might be shared with other trees, so its values must not be appended to in place.
+			n.Interval.Vals = slices.Clip(n.Interval.Vals)
 			n.Right = deleteMin(n.Right, cmp)
 		} else {
 			n.Right = delete(n.Right, intervl, cmp)
@@ -69,6 +75,8 @@ func deleteMin[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
 		return nil
 	}
 
+	n = mutable(n)
+
 	if !isRed(n.Left) && !isRed(n.Left.Left) {
 		n = moveRedLeft(n, cmp)
 	}
@@ -111,6 +119,7 @@ func (st *SearchTree[V, T]) DeleteMax() {
 }
 
 func deleteMax[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
+	n = mutable(n)
 	if isRed(n.Left) {
 		n = rotateRight(n, cmp)
 	}
@@ -189,3 +198,75 @@ func (st *MultiValueSearchTree[V, T]) DeleteMax() {
 		st.root.Color = black
 	}
 }
+
+// DeleteValue removes every value equal to the given val from the values of the given start and end interval key
+// in the given tree, removing the interval key from the tree as well if no values are left.
+// It does nothing if the given start and end interval key doesn't exist in the tree.
+//
+// Values are compared with the equality function configured with TreeWithValueEquality, if any,
+// or with the == operator otherwise. DeleteValue is a function rather than a method, so that V is required
+// to be comparable; to remove values that aren't, or with any other criteria, see DeleteValueFunc.
+//
+// DeleteValue returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func DeleteValue[V comparable, T any](st *MultiValueSearchTree[V, T], start, end T, val V) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	eq := comparableEqual[V](st.config)
+	return st.deleteValue(start, end, func(v V) bool {
+		return eq(v, val)
+	})
+}
+
+// DeleteValueFunc removes every value for which del returns true from the values of the given start and end interval key,
+// removing the interval key from the tree as well if no values are left.
+// It does nothing if the given start and end interval key doesn't exist in the tree.
+//
+// DeleteValueFunc returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (st *MultiValueSearchTree[V, T]) DeleteValueFunc(start, end T, del func(V) bool) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.deleteValue(start, end, del)
+}
+
+func (st *MultiValueSearchTree[V, T]) deleteValue(start, end T, del func(V) bool) error {
+	intervl := interval[V, T]{
+		Start:      start,
+		End:        end,
+		AllowPoint: st.config.allowIntervalPoint,
+	}
+
+	if intervl.isInvalid(st.cmp) {
+		return newInvalidIntervalError(intervl)
+	}
+
+	found, ok := find(st.root, start, end, st.cmp)
+	if !ok {
+		return nil
+	}
+
+	// The values are copied rather than filtered in place, as they might be shared with snapshots and clones of the tree.
+	var vals []V
+	for _, v := range found.Vals {
+		if !del(v) {
+			vals = append(vals, v)
+		}
+	}
+
+	switch {
+	case len(vals) == len(found.Vals):
+		return nil
+	case len(vals) == 0:
+		st.root = delete(st.root, found, st.cmp)
+	default:
+		found.Vals = vals
+		st.root = upsert(st.root, found, st.cmp)
+	}
+
+	if st.root != nil {
+		st.root.Color = black
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package interval
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -403,3 +405,97 @@ func TestMultiValueSearchTree_DeleteMax_EmptyTree(t *testing.T) {
 		t.Errorf("st.Size(): got size %d; want %d", got, want)
 	}
 }
+
+func TestDeleteValue(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "value1", "value2", "value1")
+	st.Insert(5, 9, "value3")
+	st.InsertWithBounds(3, 7, HalfOpen, "value4", "value5")
+
+	if err := DeleteValue(st, 1, 5, "value1"); err != nil {
+		t.Fatalf("DeleteValue(st, 1, 5, value1): got unexpected error %v", err)
+	}
+
+	if got, want := mustFind(t, st, 1, 5), []string{"value2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Find(1, 5): got unexpected values %v; want %v", got, want)
+	}
+
+	// Values not found under the interval key are left untouched.
+	DeleteValue(st, 5, 9, "value1")
+	DeleteValue(st, 10, 12, "value3")
+
+	if got, want := mustFind(t, st, 5, 9), []string{"value3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Find(5, 9): got unexpected values %v; want %v", got, want)
+	}
+
+	DeleteValue(st, 5, 9, "value3")
+
+	if vals, ok := st.Find(5, 9); ok {
+		t.Errorf("st.Find(5, 9): got unexpected values %v", vals)
+	}
+
+	if got, want := st.Size(), 2; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+
+	DeleteValue(st, 3, 7, "value4")
+
+	want := Entry[string, int]{Start: 3, End: 7, Bounds: HalfOpen, Vals: []string{"value5"}}
+	if got, _ := st.MaxEntry(); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.MaxEntry(): got unexpected entry %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_DeleteValueFunc(t *testing.T) {
+	st := NewMultiValueSearchTree[[]int](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, []int{1}, []int{2, 3}, []int{4})
+
+	st.DeleteValueFunc(1, 5, func(v []int) bool { return len(v) == 1 })
+
+	if got, want := mustFind(t, st, 1, 5), [][]int{{2, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Find(1, 5): got unexpected values %v; want %v", got, want)
+	}
+
+	st.DeleteValueFunc(1, 5, func(v []int) bool { return true })
+
+	if got, want := st.Size(), 0; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+}
+
+func TestDeleteValue_Snapshot(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+
+	st.Insert(1, 5, "value1", "value2")
+	snap := st.Snapshot()
+
+	DeleteValue(st, 1, 5, "value1")
+
+	if got, _ := snap.Find(1, 5); !reflect.DeepEqual(got, []string{"value1", "value2"}) {
+		t.Errorf("snap.Find(1, 5): got unexpected values %v", got)
+	}
+}
+
+func TestDeleteValue_Error(t *testing.T) {
+	st := NewMultiValueSearchTree[int](func(x, y int) int { return x - y })
+
+	err := DeleteValue(st, 5, 1, 0)
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("DeleteValue(st, 5, 1, 0): got error type %T; want it to be %T", err, wantErr)
+	}
+}
+
+func mustFind[V, T any](t *testing.T, st *MultiValueSearchTree[V, T], start, end T) []V {
+	t.Helper()
+
+	vals, ok := st.Find(start, end)
+	if !ok {
+		t.Fatalf("st.Find(%v, %v): got no values", start, end)
+	}
+	return vals
+}
@@ -0,0 +1,195 @@
+package interval
+
+import "container/heap"
+
+// MaxDepth returns the maximum number of intervals in the tree that overlap at any point
+// from the given start to the given end, along with the point where that depth is first reached.
+// The bounds of the range are the ones configured for the tree.
+//
+// When the intervals reaching the maximum depth exclude their start, the depth is reached right after the returned point.
+// If no interval intersects the range, MaxDepth returns 0 and the zero value of T.
+func (st *SearchTree[V, T]) MaxDepth(start, end T) (depth int, at T) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxDepth(start, end)
+}
+
+// MaxDepth returns the maximum number of intervals in the tree that overlap at any point
+// from the given start to the given end, along with the point where that depth is first reached.
+// The bounds of the range are the ones configured for the tree.
+//
+// When the intervals reaching the maximum depth exclude their start, the depth is reached right after the returned point.
+// If no interval intersects the range, MaxDepth returns 0 and the zero value of T.
+func (st *SearchTreeView[V, T]) MaxDepth(start, end T) (depth int, at T) {
+	live := st.live()
+	return maxDepth(st.root, start, end, st.config, st.cmp, func(it interval[V, T]) int {
+		if !live(it) {
+			return 0
+		}
+		return 1
+	})
+}
+
+// DepthAt returns the number of intervals in the tree that contain the given point.
+func (st *SearchTree[V, T]) DepthAt(point T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().DepthAt(point)
+}
+
+// DepthAt returns the number of intervals in the tree that contain the given point.
+func (st *SearchTreeView[V, T]) DepthAt(point T) int {
+	return st.countStab(point)
+}
+
+// MaxDepth returns the maximum number of intervals in the tree that overlap at any point
+// from the given start to the given end, along with the point where that depth is first reached.
+// The bounds of the range are the ones configured for the tree.
+// If the tree is configured with TreeWithValueDepth, the values of the intervals are counted instead.
+//
+// When the intervals reaching the maximum depth exclude their start, the depth is reached right after the returned point.
+// If no interval intersects the range, MaxDepth returns 0 and the zero value of T.
+func (st *MultiValueSearchTree[V, T]) MaxDepth(start, end T) (depth int, at T) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxDepth(start, end)
+}
+
+// MaxDepth returns the maximum number of intervals in the tree that overlap at any point
+// from the given start to the given end, along with the point where that depth is first reached.
+// The bounds of the range are the ones configured for the tree.
+// If the tree is configured with TreeWithValueDepth, the values of the intervals are counted instead.
+//
+// When the intervals reaching the maximum depth exclude their start, the depth is reached right after the returned point.
+// If no interval intersects the range, MaxDepth returns 0 and the zero value of T.
+func (st *MultiValueSearchTreeView[V, T]) MaxDepth(start, end T) (depth int, at T) {
+	return maxDepth(st.root, start, end, st.config, st.cmp, st.depthWeight())
+}
+
+// DepthAt returns the number of intervals in the tree that contain the given point.
+// If the tree is configured with TreeWithValueDepth, the values of the intervals are counted instead.
+func (st *MultiValueSearchTree[V, T]) DepthAt(point T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().DepthAt(point)
+}
+
+// DepthAt returns the number of intervals in the tree that contain the given point.
+// If the tree is configured with TreeWithValueDepth, the values of the intervals are counted instead.
+func (st *MultiValueSearchTreeView[V, T]) DepthAt(point T) int {
+	weight := st.depthWeight()
+
+	var depth int
+	stab(st.root, point, st.cmp, func(it interval[V, T]) bool {
+		depth += weight(it)
+		return true
+	})
+
+	return depth
+}
+
+func (st *MultiValueSearchTreeView[V, T]) depthWeight() func(interval[V, T]) int {
+	if st.config.valueDepth {
+		return valueWeight[V, T]
+	}
+	return keyWeight[V, T]
+}
+
+func keyWeight[V, T any](interval[V, T]) int {
+	return 1
+}
+
+func valueWeight[V, T any](it interval[V, T]) int {
+	return len(it.Vals)
+}
+
+// maxDepth sweeps the intervals in root that intersect the range from the given start to the given end
+// in ascending order, keeping the ones that overlap the current interval in a heap ordered by their end.
+// As all of them contain the start of the current interval, or the point right after it, the heap holds
+// a set of overlapping intervals, whose maximum weight is the maximum depth.
+//
+// Intervals sharing the same start are swept together, the ones including their start first,
+// so that intervals ending at that point are still in the heap when the start itself is swept.
+func maxDepth[V, T any](root *node[V, T], start, end T, config TreeConfig, cmp CmpFunc[T], weight func(interval[V, T]) int) (depth int, at T) {
+	w, err := newRangeInterval[V](start, end, config, cmp)
+	if root == nil || err != nil {
+		return 0, at
+	}
+
+	active := &endHeap[V, T]{cmp: cmp}
+	var current int
+	sweep := func(it interval[V, T]) {
+		for active.Len() > 0 && !startsBeforeEnd(it, active.intervals[0], cmp) {
+			current -= weight(heap.Pop(active).(interval[V, T]))
+		}
+
+		heap.Push(active, it)
+		current += weight(it)
+
+		if current > depth {
+			depth, at = current, it.Start
+			if cmp.lt(at, w.Start) {
+				at = w.Start
+			}
+		}
+	}
+
+	var excluded []interval[V, T]
+	flush := func() {
+		for _, it := range excluded {
+			sweep(it)
+		}
+		excluded = excluded[:0]
+	}
+
+	searchInOrder(root, 0, w.Start, w.End, w.Bounds, cmp, func(_ int, it interval[V, T]) bool {
+		if len(excluded) > 0 && cmp(excluded[0].Start, it.Start) != 0 {
+			flush()
+		}
+
+		if it.includesStart(cmp) {
+			sweep(it)
+		} else {
+			excluded = append(excluded, it)
+		}
+		return true
+	})
+	flush()
+
+	return depth, at
+}
+
+// endHeap is a min-heap of intervals ordered by their end, where excluded ends come first.
+type endHeap[V, T any] struct {
+	intervals []interval[V, T]
+	cmp       CmpFunc[T]
+}
+
+func (h *endHeap[V, T]) Len() int {
+	return len(h.intervals)
+}
+
+func (h *endHeap[V, T]) Less(i, j int) bool {
+	x, y := h.intervals[i], h.intervals[j]
+	c := h.cmp(x.End, y.End)
+	return c < 0 || c == 0 && !x.includesEnd(h.cmp) && y.includesEnd(h.cmp)
+}
+
+func (h *endHeap[V, T]) Swap(i, j int) {
+	h.intervals[i], h.intervals[j] = h.intervals[j], h.intervals[i]
+}
+
+func (h *endHeap[V, T]) Push(x any) {
+	h.intervals = append(h.intervals, x.(interval[V, T]))
+}
+
+func (h *endHeap[V, T]) Pop() any {
+	n := len(h.intervals) - 1
+	it := h.intervals[n]
+	h.intervals = h.intervals[:n]
+	return it
+}
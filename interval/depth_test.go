@@ -0,0 +1,166 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSearchTree_MaxDepth(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+
+	st.Insert(1, 5, "job1")
+	st.Insert(2, 6, "job2")
+	st.Insert(4, 8, "job3")
+	st.Insert(5, 9, "job4")
+	st.Insert(6, 7, "job5")
+	st.Insert(10, 12, "job6")
+
+	testCases := []struct {
+		start, end int
+		depth      int
+		at         int
+	}{
+		{start: 0, end: 20, depth: 3, at: 4},
+		{start: 0, end: 4, depth: 2, at: 2},
+		{start: 5, end: 20, depth: 3, at: 5},
+		{start: 6, end: 7, depth: 3, at: 6},
+		{start: 9, end: 11, depth: 1, at: 10},
+		{start: 12, end: 20},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			depth, at := st.MaxDepth(tc.start, tc.end)
+			if depth != tc.depth || at != tc.at {
+				t.Errorf("st.MaxDepth(%v, %v): got unexpected value (%v, %v); want (%v, %v)", tc.start, tc.end, depth, at, tc.depth, tc.at)
+			}
+		})
+	}
+
+	for point, want := range []int{0, 1, 2, 2, 3, 3, 3, 2, 1, 0, 1, 1, 0} {
+		if got := st.DepthAt(point); got != want {
+			t.Errorf("st.DepthAt(%v): got unexpected value %v; want %v", point, got, want)
+		}
+	}
+}
+
+func TestSearchTree_MaxDepth_Random(t *testing.T) {
+	cmpFunc := func(x, y float64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	// Points are modeled in halves, so that odd indexes stand for the gaps between integers.
+	const n = 60
+	contains := func(b Bounds, start, end, p int) bool {
+		if start == end {
+			return p == 2*start
+		}
+		return (p > 2*start || p == 2*start && b.includesStart()) && (p < 2*end || p == 2*end && b.includesEnd())
+	}
+
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		t.Run(b.String(), func(t *testing.T) {
+			st := NewSearchTreeWithOptions[int](cmpFunc, TreeWithBounds(b), TreeWithIntervalPoint())
+
+			var depths [2*n + 1]int
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 40; i++ {
+				start := r.Intn(n - 10)
+				end := start + r.Intn(10)
+				ib := Bounds(r.Intn(4))
+				if _, ok := st.Find(float64(start), float64(end)); ok {
+					// Inserting an existing key would replace its bounds.
+					continue
+				}
+				st.InsertWithBounds(float64(start), float64(end), ib, i)
+				for p := range depths {
+					if contains(ib, start, end, p) {
+						depths[p]++
+					}
+				}
+			}
+
+			for p := 0; p <= 2*n; p += 2 {
+				if got := st.DepthAt(float64(p / 2)); got != depths[p] {
+					t.Fatalf("st.DepthAt(%v): got unexpected value %v; want %v", p/2, got, depths[p])
+				}
+			}
+
+			for i := 0; i < 200; i++ {
+				start := r.Intn(n)
+				end := start + r.Intn(n-start+1)
+
+				var want int
+				for p, d := range depths {
+					if contains(b, start, end, p) && d > want {
+						want = d
+					}
+				}
+
+				depth, at := st.MaxDepth(float64(start), float64(end))
+				if depth != want {
+					t.Fatalf("st.MaxDepth(%v, %v): got unexpected depth %v; want %v", start, end, depth, want)
+				}
+
+				if p := int(2 * at); depth > 0 && depths[p] != depth && (p+1 >= len(depths) || depths[p+1] != depth) {
+					t.Fatalf("st.MaxDepth(%v, %v): got unexpected point %v with depth %v", start, end, at, depths[p])
+				}
+			}
+		})
+	}
+}
+
+func TestSearchTree_MaxDepth_MixedBounds(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithIntervalPoint())
+
+	st.InsertWithBounds(0, 5, LeftHalfOpen, "job1")
+	st.InsertWithBounds(5, 5, Closed, "job2")
+	st.InsertWithBounds(5, 6, Open, "job3")
+	st.InsertWithBounds(5, 9, Closed, "job4")
+
+	if got, want := st.DepthAt(5), 3; got != want {
+		t.Errorf("st.DepthAt(5): got unexpected value %v; want %v", got, want)
+	}
+
+	if depth, at := st.MaxDepth(0, 10); depth != 3 || at != 5 {
+		t.Errorf("st.MaxDepth(0, 10): got unexpected value (%v, %v); want (3, 5)", depth, at)
+	}
+}
+
+func TestMultiValueSearchTree_MaxDepth(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	testCases := []struct {
+		name  string
+		opts  []TreeOption
+		depth int
+	}{
+		{name: "Keys", depth: 2},
+		{name: "Values", opts: []TreeOption{TreeWithValueDepth()}, depth: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := NewMultiValueSearchTreeWithOptions[string](cmpFunc, tc.opts...)
+			st.Insert(1, 5, "session1", "session2", "session3")
+			st.Insert(4, 8, "session4")
+			st.Insert(6, 9, "session5", "session6")
+
+			if depth, at := st.MaxDepth(0, 10); depth != tc.depth || at != 4 {
+				t.Errorf("st.MaxDepth(0, 10): got unexpected value (%v, %v); want (%v, 4)", depth, at, tc.depth)
+			}
+
+			if got := st.DepthAt(4); got != tc.depth {
+				t.Errorf("st.DepthAt(4): got unexpected value %v; want %v", got, tc.depth)
+			}
+		})
+	}
+}
@@ -146,3 +146,73 @@ func ExampleTreeWithIntervalPoint() {
 	// Output:
 	// event true
 }
+
+func ExampleSearchTree_AllIntersectionEntries() {
+	cmpFn := func(x, y int) int { return x - y }
+
+	st := interval.NewSearchTree[string](cmpFn)
+
+	st.Insert(17, 19, "value1")
+	st.Insert(5, 8, "value2")
+	st.Insert(21, 24, "value3")
+	st.Insert(7, 10, "value4")
+
+	entries, _ := st.AllIntersectionEntries(9, 18)
+	for _, e := range entries {
+		fmt.Println(e.Start, e.End, e.Val)
+	}
+	// Output:
+	// 7 10 value4
+	// 17 19 value1
+}
+
+func ExampleTreeWithBounds() {
+	cmpFn := func(x, y int) int { return x - y }
+
+	st := interval.NewSearchTreeWithOptions[string](cmpFn, interval.TreeWithBounds(interval.HalfOpen))
+
+	st.Insert(9, 10, "slot1")
+	st.Insert(10, 11, "slot2")
+
+	// [10, 11) doesn't intersect with [9, 10).
+	vals, ok := st.AllIntersections(10, 11)
+	fmt.Println(vals, ok)
+	// Output:
+	// [slot2] true
+}
+
+func ExampleIntervalSet() {
+	cmpFn := func(x, y int) int { return x - y }
+
+	s := interval.NewIntervalSet(cmpFn, interval.TreeWithBounds(interval.HalfOpen), interval.TreeWithAdjacentMerge())
+
+	s.Add(9, 12)
+	s.Add(12, 14)
+	s.Remove(10, 11)
+
+	for r := range s.All() {
+		fmt.Println(r.Start, r.End)
+	}
+	fmt.Println(s.Covers(11, 14))
+	// Output:
+	// 9 10
+	// 11 14
+	// true
+}
+
+func ExampleIntervalMap() {
+	cmpFn := func(x, y int) int { return x - y }
+
+	m := interval.NewIntervalMap[string](cmpFn)
+
+	m.Assign(1, 10, "price1")
+	m.Assign(4, 6, "price2")
+
+	for r, price := range m.All() {
+		fmt.Println(r.Start, r.End, price)
+	}
+	// Output:
+	// 1 4 price1
+	// 4 6 price2
+	// 6 10 price1
+}
@@ -0,0 +1,59 @@
+package interval
+
+// FirstFit returns the start of the earliest gap at or after the given from value
+// that isn't covered by any interval in the given tree and has a length of at least minLen.
+// Gaps are measured with the given dist function, and the gap after
+// all the intervals in the tree fits any length. For more details on gaps, see SearchTree.Gaps.
+//
+// The returned start is excluded from the gap when it's the included end of an interval in the tree.
+func FirstFit[V, T any, D Length](st Tree[V, T], from T, minLen D, dist DistanceFunc[T, D]) T {
+	start := from
+	st.read(func(st *SearchTreeView[V, T]) {
+		if st.root == nil || st.cmp.lt(st.root.MaxEnd, from) {
+			return
+		}
+
+		maxEnd := st.root.MaxEnd
+		w := interval[V, T]{Start: from, End: maxEnd, AllowPoint: true}
+		start = maxEnd
+
+		gaps(st.root, w, st.live(), st.cmp, func(r Range[T]) bool {
+			// Gaps including the greatest end continue past it.
+			if st.cmp.eq(r.End, maxEnd) && r.Bounds.includesEnd() || dist(r.Start, r.End) >= minLen {
+				start = r.Start
+				return false
+			}
+			return true
+		})
+	})
+
+	return start
+}
+
+// BestFit returns the shortest gap from the given from value to the given to value that isn't covered
+// by any interval in the given tree and has a length of at least minLen, or the earliest one if there's more than one.
+// Gaps are measured with the given dist function. For more details on gaps, see SearchTree.Gaps.
+//
+// If there's no such gap, BestFit returns false as the second return value.
+func BestFit[V, T any, D Length](st Tree[V, T], from, to T, minLen D, dist DistanceFunc[T, D]) (Range[T], bool) {
+	var (
+		best    Range[T]
+		bestLen D
+		found   bool
+	)
+	st.read(func(st *SearchTreeView[V, T]) {
+		w, err := newRangeInterval[V](from, to, st.config, st.cmp)
+		if err != nil {
+			return
+		}
+
+		gaps(st.root, w, st.live(), st.cmp, func(r Range[T]) bool {
+			if l := dist(r.Start, r.End); l >= minLen && (!found || l < bestLen) {
+				best, bestLen, found = r, l, true
+			}
+			return true
+		})
+	})
+
+	return best, found
+}
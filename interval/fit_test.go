@@ -0,0 +1,94 @@
+package interval
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_FirstFit(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithBounds(HalfOpen),
+		TreeWithIntervalPoint(),
+	)
+
+	st.Insert(9, 10, "meeting1")
+	st.Insert(11, 13, "meeting2")
+	st.Insert(12, 14, "meeting3")
+	st.Insert(16, 17, "meeting4")
+	st.Insert(20, 20, "deadline")
+
+	testCases := []struct {
+		from   int
+		minLen int
+		want   int
+	}{
+		{from: 0, minLen: 1, want: 0},
+		{from: 9, minLen: 1, want: 10},
+		{from: 9, minLen: 2, want: 14},
+		{from: 12, minLen: 0, want: 14},
+		{from: 15, minLen: 2, want: 17},
+		{from: 15, minLen: 4, want: 20},
+		{from: 30, minLen: 4, want: 30},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.from, tc.minLen), func(t *testing.T) {
+			if got := FirstFit(st, tc.from, tc.minLen, intDistance); got != tc.want {
+				t.Errorf("FirstFit(%v, %v): got unexpected value %v; want %v", tc.from, tc.minLen, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_BestFit(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithBounds(HalfOpen),
+	)
+
+	st.Insert(9, 10, "meeting1")
+	st.Insert(13, 14, "meeting2")
+	st.Insert(16, 17, "meeting3")
+
+	testCases := []struct {
+		from, to int
+		minLen   int
+		want     Range[int]
+		ok       bool
+	}{
+		{from: 8, to: 20, minLen: 2, want: Range[int]{Start: 14, End: 16, Bounds: HalfOpen}, ok: true},
+		{from: 8, to: 20, minLen: 3, want: Range[int]{Start: 10, End: 13, Bounds: HalfOpen}, ok: true},
+		{from: 8, to: 20, minLen: 1, want: Range[int]{Start: 8, End: 9, Bounds: HalfOpen}, ok: true},
+		{from: 8, to: 20, minLen: 4},
+		{from: 20, to: 8, minLen: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.from, tc.to, tc.minLen), func(t *testing.T) {
+			got, ok := BestFit(st, tc.from, tc.to, tc.minLen, intDistance)
+			if ok != tc.ok || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("BestFit(%v, %v, %v): got unexpected value (%v, %v); want (%v, %v)", tc.from, tc.to, tc.minLen, got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestMultiValueSearchTree_FirstFit_BestFit(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+
+	st.Insert(2, 4, "node1", "node2")
+	st.Insert(6, 7, "node3")
+
+	if got, want := FirstFit(st, 0, 3, intDistance), 7; got != want {
+		t.Errorf("FirstFit(0, 3): got unexpected value %v; want %v", got, want)
+	}
+
+	want := Range[int]{Start: 0, End: 2, Bounds: HalfOpen}
+	if got, ok := BestFit(st, 0, 10, 2, intDistance); !ok || got != want {
+		t.Errorf("BestFit(0, 10, 2): got unexpected value (%v, %v); want %v", got, ok, want)
+	}
+}
+
+func intDistance(x, y int) int {
+	return y - x
+}
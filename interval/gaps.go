@@ -0,0 +1,184 @@
+package interval
+
+import "iter"
+
+// Gaps returns the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. The bounds of the window are the ones configured for the tree,
+// and the bounds of the returned ranges exclude the endpoints covered by the intervals in the tree.
+//
+// Gaps returns nil if the given end is less than the given start value,
+// or equal to it and the tree isn't configured with TreeWithIntervalPoint.
+func (st *SearchTree[V, T]) Gaps(start, end T) []Range[T] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Gaps(start, end)
+}
+
+// Gaps returns the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. The bounds of the window are the ones configured for the tree,
+// and the bounds of the returned ranges exclude the endpoints covered by the intervals in the tree.
+//
+// Gaps returns nil if the given end is less than the given start value,
+// or equal to it and the tree isn't configured with TreeWithIntervalPoint.
+func (st *SearchTreeView[V, T]) Gaps(start, end T) []Range[T] {
+	var ranges []Range[T]
+	for r := range st.GapsSeq(start, end) {
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// GapsSeq returns an iterator over the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. For more details, see Gaps.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *SearchTree[V, T]) GapsSeq(start, end T) iter.Seq[Range[T]] {
+	return func(yield func(Range[T]) bool) {
+		st.Snapshot().GapsSeq(start, end)(yield)
+	}
+}
+
+// GapsSeq returns an iterator over the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. For more details, see Gaps.
+func (st *SearchTreeView[V, T]) GapsSeq(start, end T) iter.Seq[Range[T]] {
+	return func(yield func(Range[T]) bool) {
+		w, err := newRangeInterval[V](start, end, st.config, st.cmp)
+		if err != nil {
+			return
+		}
+
+		gaps(st.root, w, st.live(), st.cmp, yield)
+	}
+}
+
+// Gaps returns the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. The bounds of the window are the ones configured for the tree,
+// and the bounds of the returned ranges exclude the endpoints covered by the intervals in the tree.
+//
+// Gaps returns nil if the given end is less than the given start value,
+// or equal to it and the tree isn't configured with TreeWithIntervalPoint.
+func (st *MultiValueSearchTree[V, T]) Gaps(start, end T) []Range[T] {
+	return (*SearchTree[V, T])(st).Gaps(start, end)
+}
+
+// Gaps returns the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. The bounds of the window are the ones configured for the tree,
+// and the bounds of the returned ranges exclude the endpoints covered by the intervals in the tree.
+//
+// Gaps returns nil if the given end is less than the given start value,
+// or equal to it and the tree isn't configured with TreeWithIntervalPoint.
+func (st *MultiValueSearchTreeView[V, T]) Gaps(start, end T) []Range[T] {
+	return (*SearchTreeView[V, T])(st).Gaps(start, end)
+}
+
+// GapsSeq returns an iterator over the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. For more details, see Gaps.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *MultiValueSearchTree[V, T]) GapsSeq(start, end T) iter.Seq[Range[T]] {
+	return (*SearchTree[V, T])(st).GapsSeq(start, end)
+}
+
+// GapsSeq returns an iterator over the ranges from the given start to the given end, in ascending order,
+// which aren't covered by any interval in the tree. For more details, see Gaps.
+func (st *MultiValueSearchTreeView[V, T]) GapsSeq(start, end T) iter.Seq[Range[T]] {
+	return (*SearchTreeView[V, T])(st).GapsSeq(start, end)
+}
+
+// gaps calls yield with the ranges of the window w not covered by any interval in n for which live returns true,
+// in ascending order, until yield returns false. It sweeps the intervals in n by their start, keeping track
+// of the first point not yet covered, and skips the subtrees whose intervals all end before it.
+func gaps[V, T any](n *node[V, T], w interval[V, T], live func(interval[V, T]) bool, cmp CmpFunc[T], yield func(Range[T]) bool) {
+	cur, includesCur := w.Start, w.includesStart(cmp)
+	includesEnd := w.includesEnd(cmp)
+
+	// emit yields the gap from cur up to the given end, if it's not empty.
+	emit := func(end T, includesEnd bool) bool {
+		c := cmp(cur, end)
+		if c > 0 || c == 0 && !(includesCur && includesEnd) {
+			return true
+		}
+
+		b := boundsOf(includesCur, includesEnd)
+		if c == 0 {
+			b = Closed
+		}
+		return yield(Range[T]{Start: cur, End: end, Bounds: b})
+	}
+
+	// pastEnd reports whether there are no more points in the window from cur on.
+	pastEnd := func() bool {
+		c := cmp(cur, w.End)
+		return c > 0 || c == 0 && !(includesCur && includesEnd)
+	}
+
+	// cover emits the gap before the given interval, if any, and moves cur past it.
+	cover := func(it interval[V, T]) bool {
+		// The gap before the interval ends right before its start, unless the window ends first.
+		end, includes := it.Start, !it.includesStart(cmp)
+		if cmp.eq(it.Start, w.End) {
+			includes = includes && includesEnd
+		}
+		if !emit(end, includes) {
+			return false
+		}
+
+		if c := cmp(it.End, cur); c > 0 || c == 0 && it.includesEnd(cmp) && includesCur {
+			cur, includesCur = it.End, !it.includesEnd(cmp)
+		}
+		return true
+	}
+
+	// Intervals with the same start are covered at once, since whether their start
+	// is covered depends on all of them.
+	var group interval[V, T]
+	var grouped, stopped bool
+	flush := func() bool {
+		if grouped && !cover(group) {
+			stopped = true
+			return false
+		}
+		grouped = false
+		return true
+	}
+
+	var sweep func(n *node[V, T]) bool
+	sweep = func(n *node[V, T]) bool {
+		if n == nil || cmp.lt(n.MaxEnd, cur) {
+			return true
+		}
+
+		if !sweep(n.Left) {
+			return false
+		}
+
+		it := n.Interval
+		if !live(it) {
+			return sweep(n.Right)
+		}
+
+		if grouped && cmp.eq(it.Start, group.Start) {
+			// Intervals with the same start are sorted by their end.
+			includesStart := group.includesStart(cmp) || it.includesStart(cmp)
+			group.End, group.Bounds = it.End, boundsOf(includesStart, it.includesEnd(cmp))
+			return sweep(n.Right)
+		}
+
+		if !flush() || cmp.gt(it.Start, w.End) || pastEnd() {
+			return false
+		}
+		group, grouped = it, true
+
+		return sweep(n.Right)
+	}
+
+	if sweep(n) {
+		flush()
+	}
+	if !stopped && !pastEnd() {
+		emit(w.End, includesEnd)
+	}
+}
@@ -0,0 +1,159 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_Gaps(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+
+	st.Insert(9, 10, "meeting1")
+	st.Insert(11, 13, "meeting2")
+	st.Insert(12, 14, "meeting3")
+	st.Insert(16, 17, "meeting4")
+
+	testCases := []struct {
+		start, end int
+		want       []Range[int]
+	}{
+		{
+			start: 8, end: 18,
+			want: []Range[int]{
+				{Start: 8, End: 9, Bounds: HalfOpen},
+				{Start: 10, End: 11, Bounds: HalfOpen},
+				{Start: 14, End: 16, Bounds: HalfOpen},
+				{Start: 17, End: 18, Bounds: HalfOpen},
+			},
+		},
+		{
+			start: 12, end: 15,
+			want: []Range[int]{
+				{Start: 14, End: 15, Bounds: HalfOpen},
+			},
+		},
+		{start: 11, end: 14},
+		{start: 14, end: 14},
+		{
+			start: 0, end: 5,
+			want: []Range[int]{
+				{Start: 0, End: 5, Bounds: HalfOpen},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.start, tc.end), func(t *testing.T) {
+			if got := st.Gaps(tc.start, tc.end); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("st.Gaps(%v, %v): got unexpected ranges %v; want %v", tc.start, tc.end, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_GapsSeq_Break(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	st.Insert(2, 3, "node1")
+	st.Insert(5, 6, "node2")
+
+	var got []Range[int]
+	for r := range st.GapsSeq(0, 10) {
+		got = append(got, r)
+		break
+	}
+
+	if want := []Range[int]{{Start: 0, End: 2, Bounds: HalfOpen}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.GapsSeq(0, 10): got unexpected ranges %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_Gaps_Random(t *testing.T) {
+	cmpFunc := func(x, y float64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	// Points are modeled in halves, so that odd indexes stand for the gaps between integers.
+	const n = 60
+	contains := func(b Bounds, start, end, p int) bool {
+		if start == end {
+			return p == 2*start
+		}
+		return (p > 2*start || p == 2*start && b.includesStart()) && (p < 2*end || p == 2*end && b.includesEnd())
+	}
+
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		t.Run(b.String(), func(t *testing.T) {
+			st := NewSearchTreeWithOptions[int](cmpFunc, TreeWithBounds(b), TreeWithIntervalPoint())
+
+			var covered [2*n + 1]bool
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 25; i++ {
+				start := r.Intn(n - 5)
+				end := start + r.Intn(5)
+				ib := Bounds(r.Intn(4))
+				if _, ok := st.Find(float64(start), float64(end)); ok {
+					// Inserting an existing key would replace its bounds.
+					continue
+				}
+				st.InsertWithBounds(float64(start), float64(end), ib, i)
+				for p := range covered {
+					if contains(ib, start, end, p) {
+						covered[p] = true
+					}
+				}
+			}
+
+			for i := 0; i < 200; i++ {
+				start := r.Intn(n)
+				end := start + r.Intn(n-start+1)
+
+				var want []Range[float64]
+				for p := 0; p < len(covered); p++ {
+					if !contains(b, start, end, p) || covered[p] {
+						continue
+					}
+
+					q := p
+					for q+1 < len(covered) && contains(b, start, end, q+1) && !covered[q+1] {
+						q++
+					}
+
+					g := Range[float64]{Start: float64(p / 2), End: float64((q + 1) / 2)}
+					g.Bounds = boundsOf(p%2 == 0, q%2 == 0)
+					if p == q && p%2 == 0 {
+						g.Bounds = Closed
+					}
+					want = append(want, g)
+					p = q
+				}
+
+				if got := st.Gaps(float64(start), float64(end)); !reflect.DeepEqual(got, want) {
+					t.Fatalf("st.Gaps(%v, %v): got unexpected ranges %v; want %v", start, end, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMultiValueSearchTree_Gaps(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	st.Insert(2, 3, "node1", "node2")
+
+	want := []Range[int]{
+		{Start: 0, End: 2, Bounds: HalfOpen},
+		{Start: 3, End: 5, Bounds: LeftHalfOpen},
+	}
+
+	if got := st.Gaps(0, 5); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Gaps(0, 5): got unexpected ranges %v; want %v", got, want)
+	}
+}
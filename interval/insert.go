@@ -18,6 +18,35 @@ func (st *SearchTree[V, T]) Insert(start, end T, val V) error {
 		End:        end,
 		Val:        val,
 		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     st.config.bounds,
+	}
+
+	if intervl.isInvalid(st.cmp) {
+		return newInvalidIntervalError(intervl)
+	}
+
+	st.root = upsert(st.root, intervl, st.cmp)
+	st.root.Color = black
+
+	return nil
+}
+
+// InsertWithBounds inserts the given val with the given start and end as the interval key,
+// using the given bounds for the interval instead of the ones configured for the tree.
+// Interval keys are compared regardless of their bounds, so if there's already an interval key entry
+// with the given start and end interval, it will be updated with the given val and bounds.
+//
+// InsertWithBounds returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (st *SearchTree[V, T]) InsertWithBounds(start, end T, b Bounds, val V) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	intervl := interval[V, T]{
+		Start:      start,
+		End:        end,
+		Val:        val,
+		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     b,
 	}
 
 	if intervl.isInvalid(st.cmp) {
@@ -35,6 +64,8 @@ func upsert[V, T any](n *node[V, T], intervl interval[V, T], cmp CmpFunc[T]) *no
 		return newNode(intervl, red)
 	}
 
+	n = mutable(n)
+
 	switch {
 	case intervl.equal(n.Interval.Start, n.Interval.End, cmp):
 		n.Interval = intervl
@@ -68,7 +99,11 @@ func newEmptyValueListError[V, T any](it interval[V, T], action string) error {
 
 // Insert inserts the given vals with the given start and end as the interval key.
 // If there's already an interval key entry with the given start and end interval,
-// Insert will append the given vals to the exiting interval key.
+// Insert will append the given vals to the exiting interval key, keeping its bounds.
+// Otherwise, the interval key has the bounds configured for the tree.
+//
+// If the tree is configured with TreeWithValueEquality and any of the given vals is already stored under
+// the interval key, or is repeated in vals, Insert returns a DuplicateValueError without inserting any of them.
 //
 // Insert returns an InvalidIntervalError if the given end is less than or equal to the given start value,
 // or an EmptyValueListError if vals is an empty list.
@@ -80,6 +115,41 @@ func (st *MultiValueSearchTree[V, T]) Insert(start, end T, vals ...V) error {
 		End:        end,
 		Vals:       vals,
 		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     st.config.bounds,
+	}
+
+	if intervl.isInvalid(st.cmp) {
+		return newInvalidIntervalError(intervl)
+	}
+
+	if len(vals) == 0 {
+		return newEmptyValueListError(intervl, "insert")
+	}
+
+	return st.insert(intervl, false)
+}
+
+// InsertWithBounds inserts the given vals with the given start and end as the interval key,
+// using the given bounds for the interval instead of the ones configured for the tree.
+// Interval keys are compared regardless of their bounds, so if there's already an interval key entry
+// with the given start and end interval, InsertWithBounds will append the given vals to the existing
+// interval key and update its bounds.
+//
+// If the tree is configured with TreeWithValueEquality and any of the given vals is already stored under
+// the interval key, or is repeated in vals, InsertWithBounds returns a DuplicateValueError without inserting
+// any of them, nor updating the bounds.
+//
+// InsertWithBounds returns an InvalidIntervalError if the given end is less than or equal to the given start value,
+// or an EmptyValueListError if vals is an empty list.
+func (st *MultiValueSearchTree[V, T]) InsertWithBounds(start, end T, b Bounds, vals ...V) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	intervl := interval[V, T]{
+		Start:      start,
+		End:        end,
+		Vals:       vals,
+		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     b,
 	}
 
 	if intervl.isInvalid(st.cmp) {
@@ -90,24 +160,44 @@ func (st *MultiValueSearchTree[V, T]) Insert(start, end T, vals ...V) error {
 		return newEmptyValueListError(intervl, "insert")
 	}
 
-	st.root = insert(st.root, intervl, st.cmp)
+	return st.insert(intervl, true)
+}
+
+// insert inserts the values of intervl into the tree, unless the tree is configured with TreeWithValueEquality
+// and any of them is a duplicate, in which case the tree is left unchanged.
+// The bounds of an existing interval key are only replaced with the ones of intervl if setBounds is true.
+func (st *MultiValueSearchTree[V, T]) insert(intervl interval[V, T], setBounds bool) error {
+	if eq := valueSetEqual[V](st.config); eq != nil {
+		if dup, ok := duplicateValue(st.root, intervl, eq, st.cmp); ok {
+			return newDuplicateValueError(intervl, dup)
+		}
+	}
+
+	st.root = insert(st.root, intervl, setBounds, st.cmp)
 	st.root.Color = black
 
 	return nil
 }
 
-func insert[V, T any](n *node[V, T], intervl interval[V, T], cmp CmpFunc[T]) *node[V, T] {
+// insert appends the values of intervl to its interval key in n, or inserts intervl if there's no such key,
+// replacing the bounds of an existing interval key with the ones of intervl only if setBounds is true.
+func insert[V, T any](n *node[V, T], intervl interval[V, T], setBounds bool, cmp CmpFunc[T]) *node[V, T] {
 	if n == nil {
 		return newNode(intervl, red)
 	}
 
+	n = mutable(n)
+
 	switch {
 	case intervl.equal(n.Interval.Start, n.Interval.End, cmp):
 		n.Interval.Vals = append(n.Interval.Vals, intervl.Vals...)
+		if setBounds {
+			n.Interval.Bounds = intervl.Bounds
+		}
 	case intervl.less(n.Interval.Start, n.Interval.End, cmp):
-		n.Left = insert(n.Left, intervl, cmp)
+		n.Left = insert(n.Left, intervl, setBounds, cmp)
 	default:
-		n.Right = insert(n.Right, intervl, cmp)
+		n.Right = insert(n.Right, intervl, setBounds, cmp)
 	}
 
 	if cmp.gt(intervl.End, n.MaxEnd) {
@@ -123,7 +213,10 @@ func insert[V, T any](n *node[V, T], intervl interval[V, T], cmp CmpFunc[T]) *no
 // If there's already an interval key entry with the given start and end interval,
 // it will be updated with the given vals.
 //
-// Insert returns an InvalidIntervalError if the given end is less than or equal to the given start value,
+// If the tree is configured with TreeWithValueEquality and any of the given vals is repeated in vals,
+// Upsert returns a DuplicateValueError without updating the interval key, as Insert does.
+//
+// Upsert returns an InvalidIntervalError if the given end is less than or equal to the given start value,
 // or an EmptyValueListError if vals is an empty list.
 func (st *MultiValueSearchTree[V, T]) Upsert(start, end T, vals ...V) error {
 	st.mu.Lock()
@@ -133,6 +226,7 @@ func (st *MultiValueSearchTree[V, T]) Upsert(start, end T, vals ...V) error {
 		End:        end,
 		Vals:       vals,
 		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     st.config.bounds,
 	}
 
 	if intervl.isInvalid(st.cmp) {
@@ -143,6 +237,12 @@ func (st *MultiValueSearchTree[V, T]) Upsert(start, end T, vals ...V) error {
 		return newEmptyValueListError(intervl, "upsert")
 	}
 
+	if eq := valueSetEqual[V](st.config); eq != nil {
+		if dup, ok := duplicateValue(nil, intervl, eq, st.cmp); ok {
+			return newDuplicateValueError(intervl, dup)
+		}
+	}
+
 	st.root = upsert(st.root, intervl, st.cmp)
 	st.root.Color = black
 
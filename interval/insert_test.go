@@ -293,3 +293,70 @@ func TestMultiValueSearchTree_Upsert_Error(t *testing.T) {
 		}
 	})
 }
+
+func TestSearchTree_InsertWithBounds(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1")
+	st.InsertWithBounds(5, 9, Open, "node2")
+
+	// Interval keys are compared regardless of their bounds.
+	if err := st.InsertWithBounds(1, 5, Closed, "node3"); err != nil {
+		t.Fatalf("st.InsertWithBounds(1, 5, Closed): got unexpected error %v", err)
+	}
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 5, Bounds: Closed, Val: "node3"},
+		{Start: 5, End: 9, Bounds: Open, Val: "node2"},
+	}
+
+	var got []Entry[string, int]
+	for _, e := range st.All() {
+		got = append(got, e)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("st.All(): got unexpected entries %v; want %v", got, want)
+	}
+
+	err := st.InsertWithBounds(5, 5, Closed, "node4")
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.InsertWithBounds(5, 5, Closed): got error type %T; want it to be %T", err, wantErr)
+	}
+}
+
+func TestMultiValueSearchTree_InsertWithBounds(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1")
+	st.InsertWithBounds(1, 5, HalfOpen, "node2")
+
+	want := Entry[string, int]{Start: 1, End: 5, Bounds: HalfOpen, Vals: []string{"node1", "node2"}}
+
+	got, ok := st.MinEntry()
+	if !ok {
+		t.Fatal("st.MinEntry(): got no entry")
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("st.MinEntry(): got unexpected entry %v; want %v", got, want)
+	}
+
+	// Inserting without bounds keeps the bounds of the existing interval key.
+	st.Insert(1, 5, "node3")
+	st.Apply([]Op[string, int]{{Kind: OpInsert, Start: 1, End: 5, Vals: []string{"node4"}}})
+
+	want.Vals = append(want.Vals, "node3", "node4")
+	if got, _ := st.MinEntry(); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.MinEntry(): got unexpected entry %v; want %v", got, want)
+	}
+
+	err := st.InsertWithBounds(1, 5, Open)
+	var wantErr EmptyValueListError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.InsertWithBounds(1, 5, Open): got error type %T; want it to be %T", err, wantErr)
+	}
+}
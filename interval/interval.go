@@ -3,6 +3,7 @@ package interval
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // InvalidIntervalError is a description of an invalid interval.
@@ -32,6 +33,23 @@ func newInvalidIntervalError[V, T any](it interval[V, T]) error {
 // implies cmp(x, z) > 0.
 type CmpFunc[T any] func(x, y T) int
 
+// Length is a constraint that permits any numeric type, so that distances can be compared and summed.
+type Length interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// DistanceFunc must return the distance from x to y, as a non-negative number of type D,
+// given that x is less than or equal to y.
+// It's used to measure the length of ranges of values of type T by queries such as FirstFit, Coverage and Nearest.
+// For instance, the distance between two time.Time values can be measured as a time.Duration with no loss of precision.
+type DistanceFunc[T any, D Length] func(x, y T) D
+
+// EqualFunc must report whether the values x and y are equal.
+// It's used to compare the values stored under the same interval key. See TreeWithValueEquality.
+type EqualFunc[V any] func(x, y V) bool
+
 func (f CmpFunc[T]) eq(x, y T) bool {
 	return f(x, y) == 0
 }
@@ -52,12 +70,112 @@ func (f CmpFunc[T]) gte(x, y T) bool {
 	return f(x, y) >= 0
 }
 
+// Bounds describes which endpoints are included in an interval.
+// Intervals in which the start and end values are the same always include that single point,
+// regardless of their Bounds.
+type Bounds uint8
+
+const (
+	// Closed intervals include both endpoints: [start, end].
+	Closed Bounds = iota
+	// HalfOpen intervals include the start but exclude the end: [start, end).
+	HalfOpen
+	// LeftHalfOpen intervals exclude the start but include the end: (start, end].
+	LeftHalfOpen
+	// Open intervals exclude both endpoints: (start, end).
+	Open
+)
+
+// String returns a string representation of the Bounds.
+func (b Bounds) String() string {
+	switch b {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case LeftHalfOpen:
+		return "left-half-open"
+	case Open:
+		return "open"
+	default:
+		return fmt.Sprintf("Bounds(%d)", uint8(b))
+	}
+}
+
+func (b Bounds) includesStart() bool {
+	return b == Closed || b == HalfOpen
+}
+
+func (b Bounds) includesEnd() bool {
+	return b == Closed || b == LeftHalfOpen
+}
+
+// boundsOf returns the Bounds that include the start and the end of an interval as given.
+func boundsOf(includesStart, includesEnd bool) Bounds {
+	switch {
+	case includesStart && includesEnd:
+		return Closed
+	case includesStart:
+		return HalfOpen
+	case includesEnd:
+		return LeftHalfOpen
+	default:
+		return Open
+	}
+}
+
+// Entry represents an interval key stored in the tree along with its associated value(s).
+// Entries returned from a SearchTree have their value set in Val, whereas entries
+// returned from a MultiValueSearchTree have their values set in Vals.
+// ExpiresAt is the time at which an entry inserted with InsertWithTTL expires, or zero if it never expires.
+type Entry[V, T any] struct {
+	Start     T
+	End       T
+	Bounds    Bounds
+	Val       V
+	Vals      []V
+	ExpiresAt time.Time
+}
+
+// Range represents a range of values of type T, from Start to End, with the given Bounds.
+type Range[T any] struct {
+	Start  T
+	End    T
+	Bounds Bounds
+}
+
 type interval[V, T any] struct {
 	Start      T
 	End        T
 	Val        V
 	Vals       []V
 	AllowPoint bool
+	Bounds     Bounds
+	ExpiresAt  time.Time
+}
+
+func (it interval[V, T]) entry() Entry[V, T] {
+	return Entry[V, T]{
+		Start:     it.Start,
+		End:       it.End,
+		Bounds:    it.Bounds,
+		Val:       it.Val,
+		Vals:      it.Vals,
+		ExpiresAt: it.ExpiresAt,
+	}
+}
+
+// expiring returns 1 if it has an expiry time; otherwise, 0.
+func (it interval[V, T]) expiring() int {
+	if it.ExpiresAt.IsZero() {
+		return 0
+	}
+	return 1
+}
+
+// expired reports whether it has an expiry time and it's not after now.
+func (it interval[V, T]) expired(now time.Time) bool {
+	return !it.ExpiresAt.IsZero() && !now.Before(it.ExpiresAt)
 }
 
 func (it interval[V, T]) isInvalid(cmp CmpFunc[T]) bool {
@@ -71,8 +189,53 @@ func (it interval[V, T]) less(start, end T, cmp CmpFunc[T]) bool {
 	return cmp.lt(it.Start, start) || cmp.eq(it.Start, start) && cmp.lt(it.End, end)
 }
 
-func (it interval[V, T]) intersects(start, end T, cmp CmpFunc[T]) bool {
-	return cmp.lte(it.Start, end) && cmp.lte(start, it.End)
+func (it interval[V, T]) includesStart(cmp CmpFunc[T]) bool {
+	return it.Bounds.includesStart() || cmp.eq(it.Start, it.End)
+}
+
+func (it interval[V, T]) includesEnd(cmp CmpFunc[T]) bool {
+	return it.Bounds.includesEnd() || cmp.eq(it.Start, it.End)
+}
+
+// startsBeforeEnd reports whether the start of x is before the end of y,
+// or whether both are the same point included in x and y.
+func startsBeforeEnd[V, T any](x, y interval[V, T], cmp CmpFunc[T]) bool {
+	c := cmp(x.Start, y.End)
+	return c < 0 || c == 0 && x.includesStart(cmp) && y.includesEnd(cmp)
+}
+
+func (it interval[V, T]) intersects(start, end T, b Bounds, cmp CmpFunc[T]) bool {
+	q := interval[V, T]{Start: start, End: end, Bounds: b}
+	return startsBeforeEnd(it, q, cmp) && startsBeforeEnd(q, it, cmp)
+}
+
+// contains reports whether every point in o is also in it.
+func (it interval[V, T]) contains(o interval[V, T], cmp CmpFunc[T]) bool {
+	cs, ce := cmp(it.Start, o.Start), cmp(it.End, o.End)
+	return (cs < 0 || cs == 0 && (it.includesStart(cmp) || !o.includesStart(cmp))) &&
+		(ce > 0 || ce == 0 && (it.includesEnd(cmp) || !o.includesEnd(cmp)))
+}
+
+// startsBefore reports whether it starts before o, so that o doesn't include the start of it.
+func (it interval[V, T]) startsBefore(o interval[V, T], cmp CmpFunc[T]) bool {
+	c := cmp(it.Start, o.Start)
+	return c < 0 || c == 0 && it.includesStart(cmp) && !o.includesStart(cmp)
+}
+
+// endsAfter reports whether it ends after o, so that o doesn't include the end of it.
+func (it interval[V, T]) endsAfter(o interval[V, T], cmp CmpFunc[T]) bool {
+	c := cmp(it.End, o.End)
+	return c > 0 || c == 0 && it.includesEnd(cmp) && !o.includesEnd(cmp)
+}
+
+// adjacent reports whether o starts right where it ends,
+// so that they don't have any point in common and there's no gap between them.
+func (it interval[V, T]) adjacent(o interval[V, T], cmp CmpFunc[T]) bool {
+	return cmp.eq(it.End, o.Start) && it.includesEnd(cmp) != o.includesStart(cmp)
+}
+
+func (it interval[V, T]) rng() Range[T] {
+	return Range[T]{Start: it.Start, End: it.End, Bounds: it.Bounds}
 }
 
 func (it interval[V, T]) equal(start, end T, cmp CmpFunc[T]) bool {
@@ -0,0 +1,212 @@
+package interval
+
+import "iter"
+
+// All returns an iterator over the entries of the tree in ascending interval key order,
+// yielding the rank of each entry along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *SearchTree[V, T]) All() iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		st.Snapshot().All()(yield)
+	}
+}
+
+// All returns an iterator over the entries of the tree in ascending interval key order,
+// yielding the rank of each entry along with the entry itself.
+func (st *SearchTreeView[V, T]) All() iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		inOrder(st.root, 0, yieldEntry(yield))
+	}
+}
+
+// Backward returns an iterator over the entries of the tree in descending interval key order,
+// yielding the rank of each entry along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *SearchTree[V, T]) Backward() iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		st.Snapshot().Backward()(yield)
+	}
+}
+
+// Backward returns an iterator over the entries of the tree in descending interval key order,
+// yielding the rank of each entry along with the entry itself.
+func (st *SearchTreeView[V, T]) Backward() iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		reverseOrder(st.root, 0, yieldEntry(yield))
+	}
+}
+
+// From returns an iterator over the entries of the tree which interval key is greater than or equal to
+// the given start and end interval, in ascending interval key order, yielding the rank of each entry
+// along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *SearchTree[V, T]) From(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		st.Snapshot().From(start, end)(yield)
+	}
+}
+
+// From returns an iterator over the entries of the tree which interval key is greater than or equal to
+// the given start and end interval, in ascending interval key order, yielding the rank of each entry
+// along with the entry itself.
+func (st *SearchTreeView[V, T]) From(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		inOrderFrom(st.root, 0, start, end, st.cmp, yieldEntry(yield))
+	}
+}
+
+// Intersections returns an iterator over the entries of the tree which interval key intersects with the
+// given start and end interval, in ascending interval key order, yielding the rank of each entry along with
+// the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *SearchTree[V, T]) Intersections(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		st.Snapshot().Intersections(start, end)(yield)
+	}
+}
+
+// Intersections returns an iterator over the entries of the tree which interval key intersects with the
+// given start and end interval, in ascending interval key order, yielding the rank of each entry along with
+// the entry itself.
+func (st *SearchTreeView[V, T]) Intersections(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return func(yield func(int, Entry[V, T]) bool) {
+		if st.root == nil {
+			return
+		}
+
+		searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, st.unexpired(yieldEntry(yield)))
+	}
+}
+
+// All returns an iterator over the entries of the tree in ascending interval key order,
+// yielding the rank of each entry along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *MultiValueSearchTree[V, T]) All() iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTree[V, T])(st).All()
+}
+
+// All returns an iterator over the entries of the tree in ascending interval key order,
+// yielding the rank of each entry along with the entry itself.
+func (st *MultiValueSearchTreeView[V, T]) All() iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTreeView[V, T])(st).All()
+}
+
+// Backward returns an iterator over the entries of the tree in descending interval key order,
+// yielding the rank of each entry along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *MultiValueSearchTree[V, T]) Backward() iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTree[V, T])(st).Backward()
+}
+
+// Backward returns an iterator over the entries of the tree in descending interval key order,
+// yielding the rank of each entry along with the entry itself.
+func (st *MultiValueSearchTreeView[V, T]) Backward() iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTreeView[V, T])(st).Backward()
+}
+
+// From returns an iterator over the entries of the tree which interval key is greater than or equal to
+// the given start and end interval, in ascending interval key order, yielding the rank of each entry
+// along with the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *MultiValueSearchTree[V, T]) From(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTree[V, T])(st).From(start, end)
+}
+
+// From returns an iterator over the entries of the tree which interval key is greater than or equal to
+// the given start and end interval, in ascending interval key order, yielding the rank of each entry
+// along with the entry itself.
+func (st *MultiValueSearchTreeView[V, T]) From(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTreeView[V, T])(st).From(start, end)
+}
+
+// Intersections returns an iterator over the entries of the tree which interval key intersects with the
+// given start and end interval, in ascending interval key order, yielding the rank of each entry along with
+// the entry itself.
+//
+// The iteration runs over a snapshot of the tree taken when it starts, without holding any lock,
+// so the tree can be queried and modified from within the loop body, without affecting the iteration.
+func (st *MultiValueSearchTree[V, T]) Intersections(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTree[V, T])(st).Intersections(start, end)
+}
+
+// Intersections returns an iterator over the entries of the tree which interval key intersects with the
+// given start and end interval, in ascending interval key order, yielding the rank of each entry along with
+// the entry itself.
+func (st *MultiValueSearchTreeView[V, T]) Intersections(start, end T) iter.Seq2[int, Entry[V, T]] {
+	return (*SearchTreeView[V, T])(st).Intersections(start, end)
+}
+
+func yieldEntry[V, T any](yield func(int, Entry[V, T]) bool) func(int, interval[V, T]) bool {
+	return func(rank int, it interval[V, T]) bool {
+		return yield(rank, it.entry())
+	}
+}
+
+func inOrder[V, T any](n *node[V, T], offset int, visit func(int, interval[V, T]) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if !inOrder(n.Left, offset, visit) {
+		return false
+	}
+
+	offset += size(n.Left)
+	if !visit(offset, n.Interval) {
+		return false
+	}
+
+	return inOrder(n.Right, offset+1, visit)
+}
+
+func reverseOrder[V, T any](n *node[V, T], offset int, visit func(int, interval[V, T]) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if !reverseOrder(n.Right, offset+size(n.Left)+1, visit) {
+		return false
+	}
+
+	offset += size(n.Left)
+	if !visit(offset, n.Interval) {
+		return false
+	}
+
+	return reverseOrder(n.Left, offset-size(n.Left), visit)
+}
+
+func inOrderFrom[V, T any](n *node[V, T], offset int, start, end T, cmp CmpFunc[T], visit func(int, interval[V, T]) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if n.Interval.less(start, end, cmp) {
+		return inOrderFrom(n.Right, offset+size(n.Left)+1, start, end, cmp, visit)
+	}
+
+	if !inOrderFrom(n.Left, offset, start, end, cmp, visit) {
+		return false
+	}
+
+	offset += size(n.Left)
+	if !visit(offset, n.Interval) {
+		return false
+	}
+
+	return inOrder(n.Right, offset+1, visit)
+}
@@ -0,0 +1,286 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+type rankedEntry[V, T any] struct {
+	rank  int
+	entry Entry[V, T]
+}
+
+func collectSeq2[V, T any](seq func(func(int, Entry[V, T]) bool), limit int) []rankedEntry[V, T] {
+	var got []rankedEntry[V, T]
+	for rank, e := range seq {
+		if limit > 0 && len(got) == limit {
+			break
+		}
+		got = append(got, rankedEntry[V, T]{rank: rank, entry: e})
+	}
+	return got
+}
+
+func TestSearchTree_Iterators(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+
+	ranked := func(rank, start, end int, val string) rankedEntry[string, int] {
+		return rankedEntry[string, int]{rank: rank, entry: Entry[string, int]{Start: start, End: end, Val: val}}
+	}
+
+	testCases := []struct {
+		name  string
+		seq   func(func(int, Entry[string, int]) bool)
+		limit int
+		want  []rankedEntry[string, int]
+	}{
+		{
+			name: "All",
+			seq:  st.All(),
+			want: []rankedEntry[string, int]{
+				ranked(0, 4, 8, "node4"),
+				ranked(1, 5, 8, "node2"),
+				ranked(2, 7, 10, "node6"),
+				ranked(3, 15, 18, "node5"),
+				ranked(4, 17, 19, "node1"),
+				ranked(5, 21, 24, "node3"),
+			},
+		},
+		{
+			name:  "All/Break",
+			seq:   st.All(),
+			limit: 2,
+			want: []rankedEntry[string, int]{
+				ranked(0, 4, 8, "node4"),
+				ranked(1, 5, 8, "node2"),
+			},
+		},
+		{
+			name: "Backward",
+			seq:  st.Backward(),
+			want: []rankedEntry[string, int]{
+				ranked(5, 21, 24, "node3"),
+				ranked(4, 17, 19, "node1"),
+				ranked(3, 15, 18, "node5"),
+				ranked(2, 7, 10, "node6"),
+				ranked(1, 5, 8, "node2"),
+				ranked(0, 4, 8, "node4"),
+			},
+		},
+		{
+			name:  "Backward/Break",
+			seq:   st.Backward(),
+			limit: 1,
+			want: []rankedEntry[string, int]{
+				ranked(5, 21, 24, "node3"),
+			},
+		},
+		{
+			name: "From",
+			seq:  st.From(7, 9),
+			want: []rankedEntry[string, int]{
+				ranked(2, 7, 10, "node6"),
+				ranked(3, 15, 18, "node5"),
+				ranked(4, 17, 19, "node1"),
+				ranked(5, 21, 24, "node3"),
+			},
+		},
+		{
+			name: "From/ExactKey",
+			seq:  st.From(17, 19),
+			want: []rankedEntry[string, int]{
+				ranked(4, 17, 19, "node1"),
+				ranked(5, 21, 24, "node3"),
+			},
+		},
+		{
+			name: "From/NoGreaterKey",
+			seq:  st.From(22, 23),
+		},
+		{
+			name: "Intersections",
+			seq:  st.Intersections(9, 16),
+			want: []rankedEntry[string, int]{
+				ranked(2, 7, 10, "node6"),
+				ranked(3, 15, 18, "node5"),
+			},
+		},
+		{
+			name:  "Intersections/Break",
+			seq:   st.Intersections(6, 18),
+			limit: 3,
+			want: []rankedEntry[string, int]{
+				ranked(0, 4, 8, "node4"),
+				ranked(1, 5, 8, "node2"),
+				ranked(2, 7, 10, "node6"),
+			},
+		},
+		{
+			name: "Intersections/NotFound",
+			seq:  st.Intersections(12, 14),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collectSeq2(tc.seq, tc.limit)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("st.%s: got unexpected entries %v; want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_Iterators_EmptyTree(t *testing.T) {
+	st := NewSearchTree[any](func(x, y int) int { return x - y })
+
+	seqs := map[string]func(func(int, Entry[any, int]) bool){
+		"All":           st.All(),
+		"Backward":      st.Backward(),
+		"From":          st.From(1, 10),
+		"Intersections": st.Intersections(1, 10),
+	}
+
+	for name, seq := range seqs {
+		t.Run(name, func(t *testing.T) {
+			if got := collectSeq2(seq, 0); len(got) > 0 {
+				t.Errorf("st.%s: got unexpected entries %v", name, got)
+			}
+		})
+	}
+}
+
+func TestSearchTree_All_Large(t *testing.T) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+
+	n := 1000
+	for i := n - 1; i >= 0; i-- {
+		st.Insert(i, i+1, i)
+	}
+
+	var i int
+	for rank, e := range st.All() {
+		if rank != i || e.Val != i {
+			t.Fatalf("st.All(): got unexpected entry %v at rank %d; want value %d at rank %d", e, rank, i, i)
+		}
+		i++
+	}
+
+	if i != n {
+		t.Fatalf("st.All(): got %d entries; want %d", i, n)
+	}
+
+	for rank, e := range st.Backward() {
+		i--
+		if rank != i || e.Val != i {
+			t.Fatalf("st.Backward(): got unexpected entry %v at rank %d; want value %d at rank %d", e, rank, i, i)
+		}
+	}
+}
+
+func TestSearchTree_Iterators_ModifyInLoop(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(5, 8, "node1")
+	st.Insert(17, 19, "node2")
+	st.Insert(21, 24, "node3")
+
+	want := entriesOf(st)
+
+	var got []Entry[string, int]
+	for _, e := range st.Intersections(0, 30) {
+		got = append(got, e)
+
+		if _, ok := st.Find(e.Start, e.End); !ok {
+			t.Errorf("st.Find(%v, %v): got no entry; want it to be found", e.Start, e.End)
+		}
+
+		st.Delete(e.Start, e.End)
+		st.Insert(e.Start+1, e.End+1, e.Val)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Intersections(0, 30): got unexpected entries %v; want %v", got, want)
+	}
+
+	wantAfter := []Entry[string, int]{
+		{Start: 6, End: 9, Val: "node1"},
+		{Start: 18, End: 20, Val: "node2"},
+		{Start: 22, End: 25, Val: "node3"},
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, wantAfter) {
+		t.Errorf("st.All(): got unexpected entries %v after iteration; want %v", got, wantAfter)
+	}
+}
+
+func TestMultiValueSearchTree_Iterators(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2", "node3")
+	st.Insert(21, 24, "node4")
+
+	ranked := func(rank, start, end int, vals ...string) rankedEntry[string, int] {
+		return rankedEntry[string, int]{rank: rank, entry: Entry[string, int]{Start: start, End: end, Vals: vals}}
+	}
+
+	testCases := []struct {
+		name string
+		seq  func(func(int, Entry[string, int]) bool)
+		want []rankedEntry[string, int]
+	}{
+		{
+			name: "All",
+			seq:  st.All(),
+			want: []rankedEntry[string, int]{
+				ranked(0, 5, 8, "node2", "node3"),
+				ranked(1, 17, 19, "node1"),
+				ranked(2, 21, 24, "node4"),
+			},
+		},
+		{
+			name: "Backward",
+			seq:  st.Backward(),
+			want: []rankedEntry[string, int]{
+				ranked(2, 21, 24, "node4"),
+				ranked(1, 17, 19, "node1"),
+				ranked(0, 5, 8, "node2", "node3"),
+			},
+		},
+		{
+			name: "From",
+			seq:  st.From(6, 7),
+			want: []rankedEntry[string, int]{
+				ranked(1, 17, 19, "node1"),
+				ranked(2, 21, 24, "node4"),
+			},
+		},
+		{
+			name: "Intersections",
+			seq:  st.Intersections(1, 17),
+			want: []rankedEntry[string, int]{
+				ranked(0, 5, 8, "node2", "node3"),
+				ranked(1, 17, 19, "node1"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collectSeq2(tc.seq, 0)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("st.%s: got unexpected entries %v; want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
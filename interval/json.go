@@ -0,0 +1,249 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// errNilCmp is returned when decoding into a tree that has no comparison function,
+// such as the zero value of a tree, which can't rebuild the tree.
+var errNilCmp = errors.New("interval: cannot decode into a tree with a nil comparison function; see NewSearchTree")
+
+// jsonTree is the JSON representation of a tree, in which E is the JSON representation of its entries.
+type jsonTree[E any] struct {
+	Type    string     `json:"type"`
+	Config  jsonConfig `json:"config"`
+	Entries []E        `json:"entries"`
+}
+
+// jsonConfig is the JSON representation of a TreeConfig.
+// Only the flags are represented, as functions such as the clock can't be encoded.
+type jsonConfig struct {
+	AllowIntervalPoint bool   `json:"allowIntervalPoint"`
+	Bounds             string `json:"bounds"`
+}
+
+// jsonEntry is the JSON representation of an entry of a SearchTree.
+type jsonEntry[V, T any] struct {
+	Start     T          `json:"start"`
+	End       T          `json:"end"`
+	Bounds    string     `json:"bounds"`
+	Value     V          `json:"value"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// jsonMultiEntry is the JSON representation of an entry of a MultiValueSearchTree.
+type jsonMultiEntry[V, T any] struct {
+	Start  T      `json:"start"`
+	End    T      `json:"end"`
+	Bounds string `json:"bounds"`
+	Values []V    `json:"values"`
+}
+
+// MarshalJSON encodes the tree as a JSON object (compatible with [encoding/json]),
+// holding its type, its config flags and its entries in ascending interval key order:
+//
+//	{"type":"SearchTree","config":{"allowIntervalPoint":false,"bounds":"closed"},"entries":[{"start":1,"end":5,"bounds":"closed","value":"a"}]}
+//
+// Entries inserted with InsertWithTTL also hold their expiry time in the "expiresAt" field.
+func (st *SearchTree[V, T]) MarshalJSON() ([]byte, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	entries := make([]jsonEntry[V, T], 0, size(st.root))
+	inOrder(st.root, 0, func(_ int, it interval[V, T]) bool {
+		e := jsonEntry[V, T]{
+			Start:  it.Start,
+			End:    it.End,
+			Bounds: it.Bounds.String(),
+			Value:  it.Val,
+		}
+		if !it.ExpiresAt.IsZero() {
+			e.ExpiresAt = &it.ExpiresAt
+		}
+		entries = append(entries, e)
+		return true
+	})
+
+	return json.Marshal(jsonTree[jsonEntry[V, T]]{
+		Type:    st.typeName(),
+		Config:  newJSONConfig(st.config),
+		Entries: entries,
+	})
+}
+
+// UnmarshalJSON decodes the tree from its JSON representation (compatible with [encoding/json]),
+// as encoded by MarshalJSON, replacing the content and the config flags of the tree.
+// Entries may be in any order and may omit their bounds, in which case the bounds of the config are used.
+// The tree is rebuilt in linear time when entries are sorted in ascending interval key order.
+//
+// UnmarshalJSON returns a TypeMismatchError if the JSON representation is of another type of tree,
+// an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// or an error if the tree has no comparison function, in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) UnmarshalJSON(data []byte) error {
+	var jt jsonTree[jsonEntry[V, T]]
+	if err := decodeJSONTree(data, st.typeName(), &jt); err != nil {
+		return err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.cmp == nil {
+		return errNilCmp
+	}
+
+	config, err := jt.Config.apply(st.config)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]Entry[V, T], len(jt.Entries))
+	for i, e := range jt.Entries {
+		b, err := parseBounds(e.Bounds, config.bounds)
+		if err != nil {
+			return err
+		}
+
+		entries[i] = Entry[V, T]{Start: e.Start, End: e.End, Bounds: b, Val: e.Value}
+		if e.ExpiresAt != nil {
+			entries[i].ExpiresAt = *e.ExpiresAt
+		}
+	}
+
+	root, err := st.build(entries, config, true)
+	if err != nil {
+		return err
+	}
+
+	st.root, st.config = root, config
+
+	return nil
+}
+
+// MarshalJSON encodes the tree as a JSON object (compatible with [encoding/json]),
+// holding its type, its config flags and its entries in ascending interval key order:
+//
+//	{"type":"MultiValueSearchTree","config":{"allowIntervalPoint":false,"bounds":"closed"},"entries":[{"start":1,"end":5,"bounds":"closed","values":["a","b"]}]}
+func (st *MultiValueSearchTree[V, T]) MarshalJSON() ([]byte, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	entries := make([]jsonMultiEntry[V, T], 0, size(st.root))
+	inOrder(st.root, 0, func(_ int, it interval[V, T]) bool {
+		entries = append(entries, jsonMultiEntry[V, T]{
+			Start:  it.Start,
+			End:    it.End,
+			Bounds: it.Bounds.String(),
+			Values: it.Vals,
+		})
+		return true
+	})
+
+	return json.Marshal(jsonTree[jsonMultiEntry[V, T]]{
+		Type:    st.typeName(),
+		Config:  newJSONConfig(st.config),
+		Entries: entries,
+	})
+}
+
+// UnmarshalJSON decodes the tree from its JSON representation (compatible with [encoding/json]),
+// as encoded by MarshalJSON, replacing the content and the config flags of the tree.
+// Entries may be in any order and may omit their bounds, in which case the bounds of the config are used.
+// The tree is rebuilt in linear time when entries are sorted in ascending interval key order.
+//
+// UnmarshalJSON returns a TypeMismatchError if the JSON representation is of another type of tree,
+// an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// an EmptyValueListError if any entry has an empty list of values,
+// or an error if the tree has no comparison function, in which case the tree is left unchanged.
+func (st *MultiValueSearchTree[V, T]) UnmarshalJSON(data []byte) error {
+	var jt jsonTree[jsonMultiEntry[V, T]]
+	if err := decodeJSONTree(data, st.typeName(), &jt); err != nil {
+		return err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.cmp == nil {
+		return errNilCmp
+	}
+
+	config, err := jt.Config.apply(st.config)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]Entry[V, T], len(jt.Entries))
+	for i, e := range jt.Entries {
+		b, err := parseBounds(e.Bounds, config.bounds)
+		if err != nil {
+			return err
+		}
+
+		entries[i] = Entry[V, T]{Start: e.Start, End: e.End, Bounds: b, Vals: e.Values}
+	}
+
+	root, err := st.build(entries, config, true)
+	if err != nil {
+		return err
+	}
+
+	st.root, st.config = root, config
+
+	return nil
+}
+
+// decodeJSONTree decodes data into jt, checking that it's the JSON representation of the wantTypeName tree.
+func decodeJSONTree[E any](data []byte, wantTypeName string, jt *jsonTree[E]) error {
+	// The type is decoded first, so that a mismatch isn't reported as an error decoding the entries.
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+
+	if header.Type != wantTypeName {
+		return TypeMismatchError{from: header.Type, to: wantTypeName}
+	}
+
+	return json.Unmarshal(data, jt)
+}
+
+func newJSONConfig(config TreeConfig) jsonConfig {
+	return jsonConfig{
+		AllowIntervalPoint: config.allowIntervalPoint,
+		Bounds:             config.bounds.String(),
+	}
+}
+
+// apply returns the given config with the flags of c.
+func (c jsonConfig) apply(config TreeConfig) (TreeConfig, error) {
+	b, err := parseBounds(c.Bounds, Closed)
+	if err != nil {
+		return config, err
+	}
+
+	config.allowIntervalPoint = c.AllowIntervalPoint
+	config.bounds = b
+
+	return config, nil
+}
+
+// parseBounds returns the Bounds which string representation is s, or def if s is empty.
+func parseBounds(s string, def Bounds) (Bounds, error) {
+	if s == "" {
+		return def, nil
+	}
+
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		if s == b.String() {
+			return b, nil
+		}
+	}
+
+	return def, fmt.Errorf("interval: unknown bounds %q", s)
+}
@@ -0,0 +1,192 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchTree_MarshalJSON(t *testing.T) {
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+
+	st.Insert(5, 8, "node1")
+	st.InsertWithBounds(1, 5, Closed, "node2")
+
+	got, err := json.Marshal(st)
+	if err != nil {
+		t.Fatalf("json.Marshal: got unexpected error %v", err)
+	}
+
+	want := `{"type":"SearchTree","config":{"allowIntervalPoint":false,"bounds":"half-open"},"entries":[` +
+		`{"start":1,"end":5,"bounds":"closed","value":"node2"},` +
+		`{"start":5,"end":8,"bounds":"half-open","value":"node1"}]}`
+	if string(got) != want {
+		t.Errorf("json.Marshal: got unexpected JSON %s; want %s", got, want)
+	}
+}
+
+func TestSearchTree_JSON_RoundTrip(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	st1 := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithIntervalPoint(), TreeWithBounds(Open), TreeWithClock(func() time.Time { return now }))
+	for i := 0; i < 100; i++ {
+		st1.Insert(i, i+i%7, "node")
+	}
+	st1.InsertWithBounds(3, 50, Closed, "node1")
+	st1.InsertWithTTL(4, 60, "node2", time.Hour)
+
+	data, err := json.Marshal(st1)
+	if err != nil {
+		t.Fatalf("json.Marshal: got unexpected error %v", err)
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	if err := json.Unmarshal(data, st2); err != nil {
+		t.Fatalf("json.Unmarshal: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Unmarshal: got unexpected entries %v; want %v", got, want)
+	}
+
+	if got, want := newJSONConfig(st2.config), newJSONConfig(st1.config); got != want {
+		t.Errorf("json.Unmarshal: got unexpected config %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_UnmarshalJSON_Unsorted(t *testing.T) {
+	data := `{"type":"SearchTree","config":{"bounds":"half-open"},"entries":[` +
+		`{"start":5,"end":8,"value":"node1"},` +
+		`{"start":1,"end":5,"bounds":"open","value":"node2"},` +
+		`{"start":5,"end":8,"value":"node3"}]}`
+
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	if err := json.Unmarshal([]byte(data), st); err != nil {
+		t.Fatalf("json.Unmarshal: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st.root)
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 5, Bounds: Open, Val: "node2"},
+		{Start: 5, End: 8, Bounds: HalfOpen, Val: "node3"},
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Unmarshal: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_UnmarshalJSON_Error(t *testing.T) {
+	t.Run("TypeMismatch", func(t *testing.T) {
+		mv := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+		mv.Insert(1, 5, "node1")
+
+		data, err := json.Marshal(mv)
+		if err != nil {
+			t.Fatalf("json.Marshal: got unexpected error %v", err)
+		}
+
+		st := NewSearchTree[string](func(x, y int) int { return x - y })
+		st.Insert(1, 2, "node2")
+
+		err = json.Unmarshal(data, st)
+		wantErr := TypeMismatchError{from: "MultiValueSearchTree", to: "SearchTree"}
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("json.Unmarshal: got unexpected error %v; want %v", err, wantErr)
+		}
+
+		if got, want := st.Size(), 1; got != want {
+			t.Errorf("st.Size(): got size %d; want %d", got, want)
+		}
+	})
+
+	t.Run("InvalidInterval", func(t *testing.T) {
+		data := `{"type":"SearchTree","entries":[{"start":5,"end":5,"value":"node1"}]}`
+
+		st := NewSearchTree[string](func(x, y int) int { return x - y })
+		err := json.Unmarshal([]byte(data), st)
+
+		var wantErr InvalidIntervalError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("json.Unmarshal: got error type %T; want it to be %T", err, wantErr)
+		}
+	})
+
+	t.Run("UnknownBounds", func(t *testing.T) {
+		data := `{"type":"SearchTree","entries":[{"start":1,"end":5,"bounds":"half-closed","value":"node1"}]}`
+
+		st := NewSearchTree[string](func(x, y int) int { return x - y })
+		if err := json.Unmarshal([]byte(data), st); err == nil {
+			t.Error("json.Unmarshal: got unexpected <nil> error; want not nil")
+		}
+	})
+
+	t.Run("NilCmp", func(t *testing.T) {
+		data := `{"type":"SearchTree","entries":[{"start":1,"end":5,"value":"node1"}]}`
+
+		var st SearchTree[string, int]
+		if err := json.Unmarshal([]byte(data), &st); !errors.Is(err, errNilCmp) {
+			t.Errorf("json.Unmarshal: got unexpected error %v; want %v", err, errNilCmp)
+		}
+	})
+}
+
+func TestMultiValueSearchTree_JSON_RoundTrip(t *testing.T) {
+	st1 := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(LeftHalfOpen))
+	st1.Insert(5, 8, "node1", "node2")
+	st1.InsertWithBounds(1, 5, Closed, "node3")
+
+	data, err := json.Marshal(st1)
+	if err != nil {
+		t.Fatalf("json.Marshal: got unexpected error %v", err)
+	}
+
+	want := `{"type":"MultiValueSearchTree","config":{"allowIntervalPoint":false,"bounds":"left-half-open"},"entries":[` +
+		`{"start":1,"end":5,"bounds":"closed","values":["node3"]},` +
+		`{"start":5,"end":8,"bounds":"left-half-open","values":["node1","node2"]}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal: got unexpected JSON %s; want %s", data, want)
+	}
+
+	st2 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	if err := json.Unmarshal(data, st2); err != nil {
+		t.Fatalf("json.Unmarshal: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf((*SearchTree[string, int])(st2)), entriesOf((*SearchTree[string, int])(st1)); !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Unmarshal: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_UnmarshalJSON_Error(t *testing.T) {
+	t.Run("TypeMismatch", func(t *testing.T) {
+		data := `{"type":"SearchTree","entries":[{"start":1,"end":5,"value":"node1"}]}`
+
+		st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+		err := json.Unmarshal([]byte(data), st)
+
+		wantErr := TypeMismatchError{from: "SearchTree", to: "MultiValueSearchTree"}
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("json.Unmarshal: got unexpected error %v; want %v", err, wantErr)
+		}
+	})
+
+	t.Run("EmptyValueList", func(t *testing.T) {
+		data := `{"type":"MultiValueSearchTree","entries":[{"start":1,"end":5,"values":[]}]}`
+
+		st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+		err := json.Unmarshal([]byte(data), st)
+
+		var wantErr EmptyValueListError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("json.Unmarshal: got error type %T; want it to be %T", err, wantErr)
+		}
+	})
+}
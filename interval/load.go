@@ -0,0 +1,315 @@
+package interval
+
+import "slices"
+
+// NewSearchTreeFromSorted returns an interval search tree loaded with the given entries,
+// configured with the given options. For more details on cmp and opts, see NewSearchTreeWithOptions.
+//
+// The tree is built in linear time when entries are sorted in ascending interval key order,
+// as returned by the All method; otherwise, entries are sorted first. If there's more than one entry
+// with the same interval key, the last one wins, as if they had been inserted one by one.
+// Entries are loaded with the bounds configured for the tree, as Load does.
+//
+// NewSearchTreeFromSorted returns an InvalidIntervalError if the end of any entry is less than or equal to its start.
+// It will panic if cmp is nil, or if opts include TreeWithValueEquality.
+func NewSearchTreeFromSorted[V, T any](cmp CmpFunc[T], entries []Entry[V, T], opts ...TreeOption) (*SearchTree[V, T], error) {
+	if cmp == nil {
+		panic("NewSearchTreeFromSorted: comparison function cmp cannot be nil")
+	}
+
+	st := NewSearchTreeWithOptions[V](cmp, opts...)
+	if err := st.Load(entries); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// Load replaces the content of the tree with the given entries.
+//
+// The tree is built in linear time when entries are sorted in ascending interval key order,
+// as returned by the All method; otherwise, entries are sorted first. If there's more than one entry
+// with the same interval key, the last one wins, as if they had been inserted one by one.
+// Entries are loaded with the bounds configured for the tree, as InsertBatch does; their Bounds are ignored.
+// To keep the Bounds of each entry, see LoadWithBounds. The ExpiresAt of each entry is kept as it is.
+//
+// Load returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) Load(entries []Entry[V, T]) error {
+	return st.load(entries, false)
+}
+
+// LoadWithBounds replaces the content of the tree with the given entries, as Load does,
+// but using the Bounds of each entry instead of the bounds configured for the tree,
+// so that the entries returned by the All method of a tree with mixed bounds can be loaded as they are.
+//
+// LoadWithBounds returns the same errors as Load, in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) LoadWithBounds(entries []Entry[V, T]) error {
+	return st.load(entries, true)
+}
+
+func (st *SearchTree[V, T]) load(entries []Entry[V, T], entryBounds bool) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	root, err := st.build(entries, st.config, entryBounds)
+	if err != nil {
+		return err
+	}
+
+	st.root = root
+
+	return nil
+}
+
+// build returns the root of a tree with the given entries and config,
+// using the Bounds of each entry if entryBounds is true, or the bounds of config otherwise.
+func (st *SearchTree[V, T]) build(entries []Entry[V, T], config TreeConfig, entryBounds bool) (*node[V, T], error) {
+	intervals, err := sortedIntervals(entries, config, entryBounds, st.cmp, func(it interval[V, T]) error {
+		return nil
+	}, func(dst *interval[V, T], src interval[V, T]) {
+		*dst = src
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildFromSorted(len(intervals), slicePuller(intervals), st.cmp), nil
+}
+
+// NewMultiValueSearchTreeFromSorted returns a multi-value interval search tree loaded with the given entries,
+// configured with the given options. For more details on cmp and opts, see NewMultiValueSearchTreeWithOptions.
+//
+// The tree is built in linear time when entries are sorted in ascending interval key order,
+// as returned by the All method; otherwise, entries are sorted first. If there's more than one entry
+// with the same interval key, their values are appended, as if they had been inserted one by one.
+// Entries are loaded with the bounds configured for the tree, as Load does.
+//
+// NewMultiValueSearchTreeFromSorted returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// an EmptyValueListError if any entry has an empty list of values, or a DuplicateValueError if the tree is configured
+// with TreeWithValueEquality and any value is repeated under the same interval key. It will panic if cmp is nil,
+// or if opts include TreeWithValueEquality for a type other than the value type V.
+func NewMultiValueSearchTreeFromSorted[V, T any](cmp CmpFunc[T], entries []Entry[V, T], opts ...TreeOption) (*MultiValueSearchTree[V, T], error) {
+	if cmp == nil {
+		panic("NewMultiValueSearchTreeFromSorted: comparison function cmp cannot be nil")
+	}
+
+	st := NewMultiValueSearchTreeWithOptions[V](cmp, opts...)
+	if err := st.Load(entries); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// Load replaces the content of the tree with the given entries.
+//
+// The tree is built in linear time when entries are sorted in ascending interval key order,
+// as returned by the All method; otherwise, entries are sorted first. If there's more than one entry
+// with the same interval key, their values are appended, as if they had been inserted one by one.
+// Entries are loaded with the bounds configured for the tree, as InsertBatch does; their Bounds are ignored.
+// To keep the Bounds of each entry, see LoadWithBounds.
+//
+// Load returns an InvalidIntervalError if the end of any entry is less than or equal to its start,
+// an EmptyValueListError if any entry has an empty list of values, or a DuplicateValueError if the tree is configured
+// with TreeWithValueEquality and any value is repeated under the same interval key, as Insert does,
+// in which case the tree is left unchanged.
+func (st *MultiValueSearchTree[V, T]) Load(entries []Entry[V, T]) error {
+	return st.load(entries, false)
+}
+
+// LoadWithBounds replaces the content of the tree with the given entries, as Load does,
+// but using the Bounds of each entry instead of the bounds configured for the tree,
+// so that the entries returned by the All method of a tree with mixed bounds can be loaded as they are.
+// If there's more than one entry with the same interval key, the bounds of the last one win.
+//
+// LoadWithBounds returns the same errors as Load, in which case the tree is left unchanged.
+func (st *MultiValueSearchTree[V, T]) LoadWithBounds(entries []Entry[V, T]) error {
+	return st.load(entries, true)
+}
+
+func (st *MultiValueSearchTree[V, T]) load(entries []Entry[V, T], entryBounds bool) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	root, err := st.build(entries, st.config, entryBounds)
+	if err != nil {
+		return err
+	}
+
+	st.root = root
+
+	return nil
+}
+
+// build returns the root of a tree with the given entries and config,
+// using the Bounds of each entry if entryBounds is true, or the bounds of config otherwise.
+func (st *MultiValueSearchTree[V, T]) build(entries []Entry[V, T], config TreeConfig, entryBounds bool) (*node[V, T], error) {
+	intervals, err := sortedIntervals(entries, config, entryBounds, st.cmp, func(it interval[V, T]) error {
+		if len(it.Vals) == 0 {
+			return newEmptyValueListError(it, "load")
+		}
+		return nil
+	}, func(dst *interval[V, T], src interval[V, T]) {
+		dst.Vals = append(slices.Clip(dst.Vals), src.Vals...)
+		dst.Bounds = src.Bounds
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if eq := valueSetEqual[V](config); eq != nil {
+		for _, it := range intervals {
+			if dup, ok := duplicateValue(nil, it, eq, st.cmp); ok {
+				return nil, newDuplicateValueError(it, dup)
+			}
+		}
+	}
+
+	return buildFromSorted(len(intervals), slicePuller(intervals), st.cmp), nil
+}
+
+// sortedIntervals validates the given entries and returns them as intervals sorted by their keys,
+// where merge is used to combine intervals with the same key. The intervals have the Bounds of their entry
+// if entryBounds is true, or the bounds of config otherwise.
+func sortedIntervals[V, T any](entries []Entry[V, T], config TreeConfig, entryBounds bool, cmp CmpFunc[T], validate func(interval[V, T]) error, merge func(dst *interval[V, T], src interval[V, T])) ([]interval[V, T], error) {
+	intervals := make([]interval[V, T], len(entries))
+
+	sorted := true
+	for i, e := range entries {
+		it := interval[V, T]{
+			Start:      e.Start,
+			End:        e.End,
+			Val:        e.Val,
+			Vals:       e.Vals,
+			AllowPoint: config.allowIntervalPoint,
+			Bounds:     config.bounds,
+			ExpiresAt:  e.ExpiresAt,
+		}
+
+		if entryBounds {
+			it.Bounds = e.Bounds
+		}
+
+		if it.isInvalid(cmp) {
+			return nil, newInvalidIntervalError(it)
+		}
+
+		if err := validate(it); err != nil {
+			return nil, err
+		}
+
+		if i > 0 && it.less(intervals[i-1].Start, intervals[i-1].End, cmp) {
+			sorted = false
+		}
+
+		intervals[i] = it
+	}
+
+	if !sorted {
+		slices.SortStableFunc(intervals, func(x, y interval[V, T]) int {
+			if c := cmp(x.Start, y.Start); c != 0 {
+				return c
+			}
+			return cmp(x.End, y.End)
+		})
+	}
+
+	// Merge adjacent intervals with the same key in place.
+	var n int
+	for i, it := range intervals {
+		if i > 0 && it.equal(intervals[n-1].Start, intervals[n-1].End, cmp) {
+			merge(&intervals[n-1], it)
+			continue
+		}
+
+		intervals[n] = it
+		n++
+	}
+
+	return intervals[:n], nil
+}
+
+func slicePuller[V, T any](intervals []interval[V, T]) func() interval[V, T] {
+	var i int
+	return func() interval[V, T] {
+		it := intervals[i]
+		i++
+		return it
+	}
+}
+
+// buildFromSorted builds a left-leaning red-black tree in linear time with the n intervals returned by next,
+// which must return them in ascending order.
+//
+// Every path from the root to a leaf has the same number h of black nodes, so the tree
+// is built as a 2-3 tree of height h, in which 3-nodes are black nodes with a red left child.
+func buildFromSorted[V, T any](n int, next func() interval[V, T], cmp CmpFunc[T]) *node[V, T] {
+	var h int
+	for k := n + 1; k > 1; k >>= 1 {
+		h++
+	}
+
+	root := buildNode(n, h, next, cmp)
+	if root != nil {
+		root.Color = black
+	}
+
+	return root
+}
+
+// buildNode builds a 2-3 tree of height h with k intervals, where 2^h-1 <= k <= 3^h-1.
+func buildNode[V, T any](k, h int, next func() interval[V, T], cmp CmpFunc[T]) *node[V, T] {
+	if k == 0 {
+		return nil
+	}
+
+	// Each subtree of height h-1 holds between lo and hi intervals.
+	lo, hi := 1<<(h-1)-1, 1
+	for i := 1; i < h && hi <= k; i++ {
+		hi *= 3
+	}
+	hi--
+
+	if k-1 <= 2*hi {
+		left := k - 1 - lo
+		if left > hi {
+			left = hi
+		}
+
+		n := &node[V, T]{Color: black}
+		n.Left = buildNode(left, h-1, next, cmp)
+		n.Interval = next()
+		n.Right = buildNode(k-1-left, h-1, next, cmp)
+
+		return withAugmentations(n, cmp)
+	}
+
+	rem := k - 2
+	a := rem - 2*lo
+	if a > hi {
+		a = hi
+	}
+	b := rem - a - lo
+	if b > hi {
+		b = hi
+	}
+
+	l := &node[V, T]{Color: red}
+	l.Left = buildNode(a, h-1, next, cmp)
+	l.Interval = next()
+	l.Right = buildNode(b, h-1, next, cmp)
+
+	n := &node[V, T]{Color: black}
+	n.Left = withAugmentations(l, cmp)
+	n.Interval = next()
+	n.Right = buildNode(rem-a-b, h-1, next, cmp)
+
+	return withAugmentations(n, cmp)
+}
+
+func withAugmentations[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
+	updateSize(n)
+	updateMaxEnd(n, cmp)
+	return n
+}
@@ -0,0 +1,274 @@
+package interval
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestNewSearchTreeFromSorted(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	for n := 0; n <= 300; n++ {
+		entries := make([]Entry[int, int], n)
+		for i := range entries {
+			entries[i] = Entry[int, int]{Start: i, End: i + 1 + (i*7)%13, Val: i}
+		}
+
+		st, err := NewSearchTreeFromSorted(cmpFunc, entries)
+		if err != nil {
+			t.Fatalf("NewSearchTreeFromSorted(%d entries): got unexpected error %v", n, err)
+		}
+		mustBeValidTree(t, st.root)
+		mustHaveConsistentMaxEnd(t, st.root, cmpFunc)
+
+		got := make([]Entry[int, int], 0, n)
+		for _, e := range st.All() {
+			got = append(got, e)
+		}
+
+		if !reflect.DeepEqual(got, entries) {
+			t.Fatalf("NewSearchTreeFromSorted(%d entries): got unexpected entries %v; want %v", n, got, entries)
+		}
+	}
+}
+
+func TestNewSearchTreeFromSorted_Unsorted(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	r := rand.New(rand.NewSource(1))
+	want := NewSearchTree[int](cmpFunc)
+
+	entries := make([]Entry[int, int], 1000)
+	for i := range entries {
+		start := r.Intn(100)
+		end := start + 1 + r.Intn(10)
+		entries[i] = Entry[int, int]{Start: start, End: end, Val: i}
+		want.Insert(start, end, i)
+	}
+
+	st, err := NewSearchTreeFromSorted(cmpFunc, entries)
+	if err != nil {
+		t.Fatalf("NewSearchTreeFromSorted: got unexpected error %v", err)
+	}
+	mustBeValidTree(t, st.root)
+	mustHaveConsistentMaxEnd(t, st.root, cmpFunc)
+
+	if got, want := entriesOf(st), entriesOf(want); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewSearchTreeFromSorted: got unexpected entries %v; want %v", got, want)
+	}
+
+	if entries[0].Val != 0 || entries[len(entries)-1].Val != len(entries)-1 {
+		t.Error("NewSearchTreeFromSorted: got input entries modified")
+	}
+}
+
+func TestNewSearchTreeFromSorted_Error(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	entries := []Entry[int, int]{
+		{Start: 1, End: 2, Val: 1},
+		{Start: 3, End: 3, Val: 2},
+	}
+
+	st, err := NewSearchTreeFromSorted(cmpFunc, entries)
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("NewSearchTreeFromSorted: got error type %T; want it to be %T", err, wantErr)
+	}
+	if st != nil {
+		t.Errorf("NewSearchTreeFromSorted: got unexpected tree %v; want nil", st)
+	}
+
+	if _, err := NewSearchTreeFromSorted(cmpFunc, entries, TreeWithIntervalPoint()); err != nil {
+		t.Errorf("NewSearchTreeFromSorted(TreeWithIntervalPoint()): got unexpected error %v", err)
+	}
+}
+
+func TestSearchTree_Load(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1")
+	st.Insert(7, 9, "node2")
+
+	err := st.Load([]Entry[string, int]{
+		{Start: 2, End: 4, Val: "node3"},
+		{Start: 0, End: 3, Val: "node4"},
+	})
+	if err != nil {
+		t.Fatalf("st.Load: got unexpected error %v", err)
+	}
+
+	want := []Entry[string, int]{
+		{Start: 0, End: 3, Val: "node4"},
+		{Start: 2, End: 4, Val: "node3"},
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Load: got unexpected entries %v; want %v", got, want)
+	}
+
+	err = st.Load([]Entry[string, int]{{Start: 5, End: 1, Val: "node5"}})
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.Load: got error type %T; want it to be %T", err, wantErr)
+	}
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Load: got unexpected entries %v after error; want %v", got, want)
+	}
+}
+
+func TestSearchTree_Load_Bounds(t *testing.T) {
+	entries := []Entry[string, int]{
+		{Start: 9, End: 10, Val: "node1"},
+		{Start: 10, End: 11, Bounds: Open, Val: "node2"},
+	}
+
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	if err := st.Load(entries); err != nil {
+		t.Fatalf("st.Load: got unexpected error %v", err)
+	}
+
+	batch := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	batch.InsertBatch(entries)
+
+	if got, want := entriesOf(st), entriesOf(batch); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Load: got unexpected entries %v; want %v", got, want)
+	}
+
+	if got, want := allIntersectionsOf(st, 10, 11), []string{"node2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.AllIntersections(10, 11): got unexpected values %v; want %v", got, want)
+	}
+
+	if err := st.LoadWithBounds(entries); err != nil {
+		t.Fatalf("st.LoadWithBounds: got unexpected error %v", err)
+	}
+	mustBeValidTree(t, st.root)
+
+	if got := entriesOf(st); !reflect.DeepEqual(got, entries) {
+		t.Errorf("st.LoadWithBounds: got unexpected entries %v; want %v", got, entries)
+	}
+}
+
+func TestNewMultiValueSearchTreeFromSorted(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	entries := []Entry[string, int]{
+		{Start: 4, End: 8, Vals: []string{"node1"}},
+		{Start: 1, End: 3, Vals: []string{"node2", "node3"}},
+		{Start: 4, End: 8, Bounds: HalfOpen, Vals: []string{"node4"}},
+		{Start: 2, End: 6, Vals: []string{"node5"}},
+	}
+
+	st, err := NewMultiValueSearchTreeFromSorted(cmpFunc, entries)
+	if err != nil {
+		t.Fatalf("NewMultiValueSearchTreeFromSorted: got unexpected error %v", err)
+	}
+	mustBeValidTree(t, st.root)
+	mustHaveConsistentMaxEnd(t, st.root, cmpFunc)
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 3, Vals: []string{"node2", "node3"}},
+		{Start: 2, End: 6, Vals: []string{"node5"}},
+		{Start: 4, End: 8, Vals: []string{"node1", "node4"}},
+	}
+
+	var got []Entry[string, int]
+	for _, e := range st.All() {
+		got = append(got, e)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewMultiValueSearchTreeFromSorted: got unexpected entries %v; want %v", got, want)
+	}
+
+	if err := st.LoadWithBounds(entries); err != nil {
+		t.Fatalf("st.LoadWithBounds: got unexpected error %v", err)
+	}
+
+	// The bounds of the last entry with the same interval key win.
+	want[2].Bounds = HalfOpen
+	if got := entriesOf((*SearchTree[string, int])(st)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.LoadWithBounds: got unexpected entries %v; want %v", got, want)
+	}
+
+	if want := []string{"node1"}; !reflect.DeepEqual(entries[0].Vals, want) {
+		t.Errorf("NewMultiValueSearchTreeFromSorted: got input values modified to %v; want %v", entries[0].Vals, want)
+	}
+}
+
+func TestNewMultiValueSearchTreeFromSorted_Error(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	t.Run("InvalidInterval", func(t *testing.T) {
+		_, err := NewMultiValueSearchTreeFromSorted(cmpFunc, []Entry[string, int]{{Start: 2, End: 1, Vals: []string{"node1"}}})
+
+		var wantErr InvalidIntervalError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("NewMultiValueSearchTreeFromSorted: got error type %T; want it to be %T", err, wantErr)
+		}
+	})
+
+	t.Run("EmptyValueList", func(t *testing.T) {
+		_, err := NewMultiValueSearchTreeFromSorted(cmpFunc, []Entry[string, int]{{Start: 1, End: 2}})
+
+		var wantErr EmptyValueListError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("NewMultiValueSearchTreeFromSorted: got error type %T; want it to be %T", err, wantErr)
+		}
+	})
+}
+
+func BenchmarkNewSearchTreeFromSorted(b *testing.B) {
+	n := 1_000_000
+	entries := make([]Entry[int, int], n)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Start: i, End: i + 10, Val: i}
+	}
+
+	cmpFunc := func(x, y int) int { return x - y }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewSearchTreeFromSorted(cmpFunc, entries)
+	}
+}
+
+func entriesOf[V, T any](st *SearchTree[V, T]) []Entry[V, T] {
+	return entriesOfView(st.Snapshot())
+}
+
+func entriesOfView[V, T any](st *SearchTreeView[V, T]) []Entry[V, T] {
+	var entries []Entry[V, T]
+	for _, e := range st.All() {
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// Tests if the max end of every node is the greatest end in its subtree.
+func mustHaveConsistentMaxEnd[V, T any](t *testing.T, root *node[V, T], cmp CmpFunc[T]) {
+	t.Helper()
+
+	var check func(n *node[V, T]) bool
+	check = func(n *node[V, T]) bool {
+		if n == nil {
+			return true
+		}
+
+		maxEnd := n.Interval.End
+		if n.Left != nil && cmp.gt(n.Left.MaxEnd, maxEnd) {
+			maxEnd = n.Left.MaxEnd
+		}
+		if n.Right != nil && cmp.gt(n.Right.MaxEnd, maxEnd) {
+			maxEnd = n.Right.MaxEnd
+		}
+
+		return cmp.eq(n.MaxEnd, maxEnd) && check(n.Left) && check(n.Right)
+	}
+
+	if !check(root) {
+		t.Fatal("Interval Tree has inconsistent max ends")
+	}
+}
@@ -0,0 +1,109 @@
+package interval
+
+import "iter"
+
+// IntervalMap is a generic map from ranges of values of type T to values of type V,
+// in which every point is mapped to at most one value.
+// Assigning a value to a range overwrites the values of the stored ranges it overlaps,
+// trimming or splitting them as needed.
+type IntervalMap[V, T any] struct {
+	st *SearchTree[V, T]
+}
+
+// NewIntervalMap returns an initialized interval map configured with the given options.
+// The cmp parameter is used for comparing total order of the values of type T.
+// The opts parameter is an optional list of TreeOptions that customize the behavior of the map,
+// such as allowing point ranges using TreeWithIntervalPoint.
+//
+// Unlike interval trees, an interval map uses HalfOpen bounds by default, so that assigning a value to [start, end)
+// leaves the value at end untouched. To use different bounds, see TreeWithBounds.
+//
+// NewIntervalMap will panic if cmp is nil, or if opts include TreeWithValueEquality.
+func NewIntervalMap[V, T any](cmp CmpFunc[T], opts ...TreeOption) *IntervalMap[V, T] {
+	if cmp == nil {
+		panic("NewIntervalMap: comparison function cmp cannot be nil")
+	}
+
+	st := &SearchTree[V, T]{
+		cmp:    cmp,
+		config: TreeConfig{bounds: HalfOpen},
+	}
+
+	for _, opt := range opts {
+		opt(&st.config)
+	}
+
+	if st.config.valueEqual != nil {
+		panic("NewIntervalMap: TreeWithValueEquality is only supported by MultiValueSearchTree")
+	}
+
+	return &IntervalMap[V, T]{st: st}
+}
+
+// Assign maps every point in the range from the given start to the given end to the given val,
+// trimming or splitting the stored ranges it overlaps, which keep their values outside of the range.
+//
+// Assign returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (m *IntervalMap[V, T]) Assign(start, end T, val V) error {
+	m.st.mu.Lock()
+	defer m.st.mu.Unlock()
+
+	r, err := newRangeInterval[V](start, end, m.st.config, m.st.cmp)
+	if err != nil {
+		return err
+	}
+	r.Val = val
+
+	m.st.root = removeRange(m.st.root, r, m.st.cmp)
+	m.st.root = upsert(m.st.root, r, m.st.cmp)
+	m.st.root.Color = black
+
+	return nil
+}
+
+// Clear unmaps every point in the range from the given start to the given end,
+// trimming or splitting the stored ranges it overlaps, which keep their values outside of the range.
+//
+// Clear returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (m *IntervalMap[V, T]) Clear(start, end T) error {
+	m.st.mu.Lock()
+	defer m.st.mu.Unlock()
+
+	r, err := newRangeInterval[V](start, end, m.st.config, m.st.cmp)
+	if err != nil {
+		return err
+	}
+
+	m.st.root = removeRange(m.st.root, r, m.st.cmp)
+
+	return nil
+}
+
+// Get returns the value the given point is mapped to.
+// If the point isn't mapped to any value, Get returns false as the second return value.
+func (m *IntervalMap[V, T]) Get(point T) (V, bool) {
+	m.st.mu.RLock()
+	defer m.st.mu.RUnlock()
+
+	it, ok := anyStab(m.st.root, point, m.st.cmp)
+	return it.Val, ok
+}
+
+// Len returns the number of ranges in the map.
+func (m *IntervalMap[V, T]) Len() int {
+	return m.st.Size()
+}
+
+// All returns an iterator over the ranges in the map and their values, in ascending order.
+// Point ranges are always reported with Closed bounds.
+//
+// The iteration runs over a snapshot of the map taken when it starts, without holding any lock,
+// so the map can be queried and modified from within the loop body, without affecting the iteration.
+func (m *IntervalMap[V, T]) All() iter.Seq2[Range[T], V] {
+	return func(yield func(Range[T], V) bool) {
+		snap := m.st.Snapshot()
+		inOrder(snap.root, 0, func(_ int, it interval[V, T]) bool {
+			return yield(it.rng(), it.Val)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package interval
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+type rangeValue[V, T any] struct {
+	Range Range[T]
+	Val   V
+}
+
+func collectRanges[V, T any](m *IntervalMap[V, T]) []rangeValue[V, T] {
+	var got []rangeValue[V, T]
+	for r, v := range m.All() {
+		got = append(got, rangeValue[V, T]{r, v})
+	}
+	return got
+}
+
+func TestIntervalMap_Assign(t *testing.T) {
+	m := NewIntervalMap[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, m.st.root)
+
+	m.Assign(1, 10, "price1")
+	m.Assign(4, 6, "price2")
+	m.Assign(8, 12, "price3")
+	m.Assign(0, 2, "price4")
+
+	want := []rangeValue[string, int]{
+		{Range[int]{Start: 0, End: 2, Bounds: HalfOpen}, "price4"},
+		{Range[int]{Start: 2, End: 4, Bounds: HalfOpen}, "price1"},
+		{Range[int]{Start: 4, End: 6, Bounds: HalfOpen}, "price2"},
+		{Range[int]{Start: 6, End: 8, Bounds: HalfOpen}, "price1"},
+		{Range[int]{Start: 8, End: 12, Bounds: HalfOpen}, "price3"},
+	}
+
+	if got := collectRanges(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("m.All(): got unexpected ranges %v; want %v", got, want)
+	}
+
+	testCases := []struct {
+		point int
+		want  string
+		ok    bool
+	}{
+		{point: 0, want: "price4", ok: true},
+		{point: 2, want: "price1", ok: true},
+		{point: 5, want: "price2", ok: true},
+		{point: 6, want: "price1", ok: true},
+		{point: 11, want: "price3", ok: true},
+		{point: 12},
+		{point: -1},
+	}
+
+	for _, tc := range testCases {
+		got, ok := m.Get(tc.point)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("m.Get(%v): got unexpected value (%v, %v); want (%v, %v)", tc.point, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestIntervalMap_Clear(t *testing.T) {
+	m := NewIntervalMap[string](func(x, y int) int { return x - y }, TreeWithBounds(Closed))
+	defer mustBeValidTree(t, m.st.root)
+
+	m.Assign(1, 10, "price1")
+	m.Clear(3, 5)
+
+	want := []rangeValue[string, int]{
+		{Range[int]{Start: 1, End: 3, Bounds: HalfOpen}, "price1"},
+		{Range[int]{Start: 5, End: 10, Bounds: LeftHalfOpen}, "price1"},
+	}
+
+	if got := collectRanges(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("m.All(): got unexpected ranges %v; want %v", got, want)
+	}
+
+	err := m.Clear(5, 5)
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("m.Clear(5, 5): got error type %T; want it to be %T", err, wantErr)
+	}
+}
+
+func TestIntervalMap_Random(t *testing.T) {
+	cmpFunc := func(x, y float64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	// Points are modeled in halves, so that odd indexes stand for the gaps between integers.
+	const n = 40
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		t.Run(b.String(), func(t *testing.T) {
+			m := NewIntervalMap[int](cmpFunc, TreeWithBounds(b), TreeWithIntervalPoint())
+
+			var model [2*n + 1]int
+			r := rand.New(rand.NewSource(1))
+			for i := 1; i <= 500; i++ {
+				start := r.Intn(n)
+				end := start + r.Intn(n-start+1)
+
+				val := i
+				if r.Intn(4) == 0 {
+					m.Clear(float64(start), float64(end))
+					val = 0
+				} else {
+					m.Assign(float64(start), float64(end), val)
+				}
+
+				for p := range model {
+					if start == end && p == 2*start ||
+						start < end && (p > 2*start || p == 2*start && b.includesStart()) && (p < 2*end || p == 2*end && b.includesEnd()) {
+						model[p] = val
+					}
+				}
+				mustBeValidTree(t, m.st.root)
+
+				for p, want := range model {
+					got, ok := m.Get(float64(p) / 2)
+					if ok != (want != 0) || got != want {
+						t.Fatalf("m.Get(%v): got (%v, %v); want %v", float64(p)/2, got, ok, want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package interval
+
+import "container/heap"
+
+// Nearest returns the interval in the given tree closest to the given point, along with its value or values.
+// The distance from the point to an interval is measured with the given dist function,
+// from the point to the closest endpoint of the interval, or 0 if the point lies within its start and end.
+// Ties are broken by interval key order.
+//
+// If the tree is empty, Nearest returns false as the second return value.
+func Nearest[V, T any, D Length](st Tree[V, T], point T, dist DistanceFunc[T, D]) (Entry[V, T], bool) {
+	entries := KNearest(st, point, 1, dist)
+	if len(entries) == 0 {
+		return Entry[V, T]{}, false
+	}
+	return entries[0], true
+}
+
+// KNearest returns up to k intervals in the given tree closest to the given point, along with their values,
+// ordered by their distance to the point. For more details on the distance, see Nearest.
+func KNearest[V, T any, D Length](st Tree[V, T], point T, k int, dist DistanceFunc[T, D]) []Entry[V, T] {
+	if k <= 0 {
+		return nil
+	}
+
+	var entries []Entry[V, T]
+	st.read(func(st *SearchTreeView[V, T]) {
+		live := st.live()
+		nearest(st.root, point, dist, st.cmp, func(it interval[V, T]) bool {
+			if live(it) {
+				entries = append(entries, it.entry())
+			}
+			return len(entries) < k
+		})
+	})
+
+	return entries
+}
+
+// nearest calls foundFn with the intervals in root ordered by their distance to the given point,
+// and then by their keys, until foundFn returns false.
+//
+// It runs a best-first search, in which subtrees are expanded by the lower bound of the distance
+// of their intervals, given by their max end and the start of their closest ancestor to the left.
+func nearest[V, T any, D Length](root *node[V, T], point T, dist DistanceFunc[T, D], cmp CmpFunc[T], foundFn func(interval[V, T]) bool) {
+	if root == nil {
+		return
+	}
+
+	q := &nearestQueue[V, T, D]{cmp: cmp}
+	heap.Push(q, nearestItem[V, T, D]{dist: subtreeDistance(root, nil, point, dist, cmp), node: root})
+
+	for q.Len() > 0 {
+		item := heap.Pop(q).(nearestItem[V, T, D])
+
+		if item.node == nil {
+			if !foundFn(item.interval) {
+				return
+			}
+			continue
+		}
+
+		n := item.node
+		heap.Push(q, nearestItem[V, T, D]{dist: intervalDistance(n.Interval, point, dist, cmp), interval: n.Interval})
+
+		if n.Left != nil {
+			heap.Push(q, nearestItem[V, T, D]{dist: subtreeDistance(n.Left, item.minStart, point, dist, cmp), node: n.Left, minStart: item.minStart})
+		}
+		if n.Right != nil {
+			minStart := &n.Interval.Start
+			heap.Push(q, nearestItem[V, T, D]{dist: subtreeDistance(n.Right, minStart, point, dist, cmp), node: n.Right, minStart: minStart})
+		}
+	}
+}
+
+func intervalDistance[V, T any, D Length](it interval[V, T], point T, dist DistanceFunc[T, D], cmp CmpFunc[T]) D {
+	switch {
+	case cmp.lt(point, it.Start):
+		return dist(point, it.Start)
+	case cmp.gt(point, it.End):
+		return dist(it.End, point)
+	default:
+		return 0
+	}
+}
+
+// subtreeDistance returns the lower bound of the distance from the given point to the intervals in n,
+// whose starts are greater than or equal to minStart, if not nil.
+func subtreeDistance[V, T any, D Length](n *node[V, T], minStart *T, point T, dist DistanceFunc[T, D], cmp CmpFunc[T]) D {
+	switch {
+	case cmp.gt(point, n.MaxEnd):
+		return dist(n.MaxEnd, point)
+	case minStart != nil && cmp.lt(point, *minStart):
+		return dist(point, *minStart)
+	default:
+		return 0
+	}
+}
+
+type nearestItem[V, T any, D Length] struct {
+	dist     D
+	node     *node[V, T] // nil for interval items
+	minStart *T
+	interval interval[V, T]
+}
+
+// nearestQueue is a priority queue of subtrees and intervals ordered by their distance,
+// where subtrees come before intervals and intervals are ordered by their keys,
+// so that intervals are popped by their distance and then by their keys.
+type nearestQueue[V, T any, D Length] struct {
+	items []nearestItem[V, T, D]
+	cmp   CmpFunc[T]
+}
+
+func (q *nearestQueue[V, T, D]) Len() int {
+	return len(q.items)
+}
+
+func (q *nearestQueue[V, T, D]) Less(i, j int) bool {
+	x, y := q.items[i], q.items[j]
+	switch {
+	case x.dist != y.dist:
+		return x.dist < y.dist
+	case x.node != nil || y.node != nil:
+		return x.node != nil && y.node == nil
+	default:
+		return x.interval.less(y.interval.Start, y.interval.End, q.cmp)
+	}
+}
+
+func (q *nearestQueue[V, T, D]) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *nearestQueue[V, T, D]) Push(x any) {
+	q.items = append(q.items, x.(nearestItem[V, T, D]))
+}
+
+func (q *nearestQueue[V, T, D]) Pop() any {
+	n := len(q.items) - 1
+	item := q.items[n]
+	q.items = q.items[:n]
+	return item
+}
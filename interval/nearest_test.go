@@ -0,0 +1,101 @@
+package interval
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSearchTree_Nearest(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+
+	if _, ok := Nearest(st, 5, intDistance); ok {
+		t.Error("Nearest(5): got an interval in an empty tree")
+	}
+
+	st.Insert(1, 3, "gene1")
+	st.Insert(8, 12, "gene2")
+	st.Insert(14, 20, "gene3")
+	st.Insert(5, 6, "gene4")
+
+	testCases := []struct {
+		point int
+		want  string
+	}{
+		{point: 0, want: "gene1"},
+		{point: 4, want: "gene1"},
+		{point: 7, want: "gene4"},
+		{point: 10, want: "gene2"},
+		{point: 13, want: "gene2"},
+		{point: 30, want: "gene3"},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Nearest(st, tc.point, intDistance)
+		if !ok || got.Val != tc.want {
+			t.Errorf("Nearest(%v): got unexpected value (%v, %v); want %v", tc.point, got.Val, ok, tc.want)
+		}
+	}
+
+	var got []string
+	for _, e := range KNearest(st, 7, 3, intDistance) {
+		got = append(got, e.Val)
+	}
+
+	if want := []string{"gene4", "gene2", "gene1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("KNearest(7, 3): got unexpected values %v; want %v", got, want)
+	}
+
+	if got := KNearest(st, 7, 0, intDistance); got != nil {
+		t.Errorf("KNearest(7, 0): got unexpected entries %v", got)
+	}
+}
+
+func TestSearchTree_KNearest_Random(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+	dist := func(x, y int) int { return y - x }
+	st := NewSearchTree[int](cmpFunc)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		start := r.Intn(1000)
+		st.Insert(start, start+1+r.Intn(30), i)
+	}
+
+	entries := entriesOf(st)
+	for i := 0; i < 100; i++ {
+		point := r.Intn(1100) - 50
+		k := r.Intn(20)
+
+		// The entries are sorted by key, so a stable sort by distance breaks ties by key.
+		want := slices.Clone(entries)
+		slices.SortStableFunc(want, func(x, y Entry[int, int]) int {
+			dx := intervalDistance(interval[int, int]{Start: x.Start, End: x.End}, point, dist, cmpFunc)
+			dy := intervalDistance(interval[int, int]{Start: y.Start, End: y.End}, point, dist, cmpFunc)
+			return cmp.Compare(dx, dy)
+		})
+		want = want[:k]
+
+		if got := KNearest(st, point, k, dist); len(got) != k || k > 0 && !reflect.DeepEqual(got, want) {
+			t.Fatalf("KNearest(%v, %v): got unexpected entries %v; want %v", point, k, got, want)
+		}
+	}
+}
+
+func TestMultiValueSearchTree_Nearest(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+
+	st.Insert(1, 3, "gene1", "gene2")
+	st.Insert(7, 9, "gene3")
+
+	want := Entry[string, int]{Start: 1, End: 3, Vals: []string{"gene1", "gene2"}}
+	if got, ok := Nearest(st, 5, intDistance); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("Nearest(5): got unexpected entry (%v, %v); want %v", got, ok, want)
+	}
+
+	if got := KNearest(st, 5, 5, intDistance); len(got) != 2 {
+		t.Errorf("KNearest(5, 5): got %v entries; want 2", len(got))
+	}
+}
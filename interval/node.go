@@ -1,6 +1,10 @@
 package interval
 
-import "math"
+import (
+	"math"
+	"slices"
+	"sync/atomic"
+)
 
 type color bool
 
@@ -16,6 +20,13 @@ type node[V, T any] struct {
 	Left     *node[V, T]
 	Color    color
 	Size     int
+
+	// Expiring is the number of intervals with an expiry time in the subtree rooted at the node.
+	Expiring int
+
+	// shared reports whether the node is reachable from more than one tree,
+	// in which case it must be copied before being modified. See mutable.
+	shared atomic.Bool
 }
 
 func newNode[V, T any](intervl interval[V, T], c color) *node[V, T] {
@@ -24,15 +35,48 @@ func newNode[V, T any](intervl interval[V, T], c color) *node[V, T] {
 		MaxEnd:   intervl.End,
 		Color:    c,
 		Size:     1,
+		Expiring: intervl.expiring(),
+	}
+}
+
+// mutable returns n if it's reachable from a single tree, or a copy of n otherwise,
+// in which case the children of n become shared by the copy and the trees n is reachable from.
+// Every helper that modifies a node must modify the node returned by mutable instead.
+func mutable[V, T any](n *node[V, T]) *node[V, T] {
+	if n == nil || !n.shared.Load() {
+		return n
+	}
+
+	if n.Left != nil {
+		n.Left.shared.Store(true)
+	}
+	if n.Right != nil {
+		n.Right.shared.Store(true)
+	}
+
+	intervl := n.Interval
+	// Clip the values so that appending to the copy never writes to the backing array of n.
+	intervl.Vals = slices.Clip(intervl.Vals)
+
+	return &node[V, T]{
+		Interval: intervl,
+		MaxEnd:   n.MaxEnd,
+		Left:     n.Left,
+		Right:    n.Right,
+		Color:    n.Color,
+		Size:     n.Size,
+		Expiring: n.Expiring,
 	}
 }
 
 func flipColors[T, V any](n *node[V, T]) {
 	n.Color = !n.Color
 	if n.Left != nil {
+		n.Left = mutable(n.Left)
 		n.Left.Color = !n.Left.Color
 	}
 	if n.Right != nil {
+		n.Right = mutable(n.Right)
 		n.Right.Color = !n.Right.Color
 	}
 }
@@ -60,6 +104,7 @@ func max[V, T any](n *node[V, T]) *node[V, T] {
 
 func updateSize[V, T any](n *node[V, T]) {
 	n.Size = 1 + size(n.Left) + size(n.Right)
+	n.Expiring = n.Interval.expiring() + expiring(n.Left) + expiring(n.Right)
 }
 
 func height[V, T any](n *node[V, T]) float64 {
@@ -77,6 +122,14 @@ func size[V, T any](n *node[V, T]) int {
 	return n.Size
 }
 
+func expiring[V, T any](n *node[V, T]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.Expiring
+}
+
 func updateMaxEnd[V, T any](n *node[V, T], cmp CmpFunc[T]) {
 	n.MaxEnd = n.Interval.End
 	if n.Left != nil && cmp.gt(n.Left.MaxEnd, n.MaxEnd) {
@@ -89,13 +142,14 @@ func updateMaxEnd[V, T any](n *node[V, T], cmp CmpFunc[T]) {
 }
 
 func rotateLeft[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
-	x := n.Right
+	x := mutable(n.Right)
 	n.Right = x.Left
 	x.Left = n
 	x.Color = n.Color
 	x.MaxEnd = n.MaxEnd
 	n.Color = red
 	x.Size = n.Size
+	x.Expiring = n.Expiring
 
 	updateSize(n)
 	updateMaxEnd(n, cmp)
@@ -103,13 +157,14 @@ func rotateLeft[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
 }
 
 func rotateRight[V, T any](n *node[V, T], cmp CmpFunc[T]) *node[V, T] {
-	x := n.Left
+	x := mutable(n.Left)
 	n.Left = x.Right
 	x.Right = n
 	x.Color = n.Color
 	x.MaxEnd = n.MaxEnd
 	n.Color = red
 	x.Size = n.Size
+	x.Expiring = n.Expiring
 
 	updateSize(n)
 	updateMaxEnd(n, cmp)
@@ -0,0 +1,270 @@
+package interval
+
+import "fmt"
+
+// Relation is one of the thirteen basic relations of Allen's interval algebra,
+// describing how an interval x relates to an interval y.
+// For more on Allen's interval algebra, see https://en.wikipedia.org/wiki/Allen%27s_interval_algebra
+type Relation uint8
+
+const (
+	// Before means that x ends before y starts.
+	Before Relation = iota
+	// Meets means that x ends where y starts.
+	Meets
+	// Overlaps means that x starts before y starts and ends within y.
+	Overlaps
+	// Starts means that x starts where y starts and ends before y ends.
+	Starts
+	// During means that x starts after y starts and ends before y ends.
+	During
+	// Finishes means that x starts after y starts and ends where y ends.
+	Finishes
+	// Equals means that x starts where y starts and ends where y ends.
+	Equals
+	// FinishedBy means that x starts before y starts and ends where y ends.
+	FinishedBy
+	// Contains means that x starts before y starts and ends after y ends.
+	Contains
+	// StartedBy means that x starts where y starts and ends after y ends.
+	StartedBy
+	// OverlappedBy means that x starts within y and ends after y ends.
+	OverlappedBy
+	// MetBy means that x starts where y ends.
+	MetBy
+	// After means that x starts after y ends.
+	After
+)
+
+var relationNames = [...]string{
+	Before:       "before",
+	Meets:        "meets",
+	Overlaps:     "overlaps",
+	Starts:       "starts",
+	During:       "during",
+	Finishes:     "finishes",
+	Equals:       "equals",
+	FinishedBy:   "finished-by",
+	Contains:     "contains",
+	StartedBy:    "started-by",
+	OverlappedBy: "overlapped-by",
+	MetBy:        "met-by",
+	After:        "after",
+}
+
+// String returns a string representation of the Relation.
+func (r Relation) String() string {
+	if int(r) < len(relationNames) {
+		return relationNames[r]
+	}
+	return fmt.Sprintf("Relation(%d)", uint8(r))
+}
+
+// Inverse returns the relation of y to x given that r is the relation of x to y.
+func (r Relation) Inverse() Relation {
+	if r > After {
+		return r
+	}
+	return After - r
+}
+
+// Relate returns the relation of the interval x, given by xStart and xEnd,
+// to the interval y, given by yStart and yEnd, according to Allen's interval algebra.
+//
+// Relate only compares the endpoints of the intervals, regardless of any Bounds.
+// As point intervals may satisfy more than one relation, the Equals, Before, After,
+// Meets and MetBy relations take precedence over the other ones, in this order.
+func Relate[T any](xStart, xEnd, yStart, yEnd T, cmp CmpFunc[T]) Relation {
+	cs, ce := cmp(xStart, yStart), cmp(xEnd, yEnd)
+	if cs == 0 && ce == 0 {
+		return Equals
+	}
+
+	es, se := cmp(xEnd, yStart), cmp(xStart, yEnd)
+	switch {
+	case es < 0:
+		return Before
+	case se > 0:
+		return After
+	case es == 0:
+		return Meets
+	case se == 0:
+		return MetBy
+	}
+
+	switch {
+	case cs == 0 && ce < 0:
+		return Starts
+	case cs == 0:
+		return StartedBy
+	case ce == 0 && cs > 0:
+		return Finishes
+	case ce == 0:
+		return FinishedBy
+	case cs < 0 && ce < 0:
+		return Overlaps
+	case cs < 0:
+		return Contains
+	case ce < 0:
+		return During
+	default:
+		return OverlappedBy
+	}
+}
+
+// Related returns a slice of values which interval key relates to the given start and end interval
+// by any of the given relations, according to Allen's interval algebra.
+// It returns true as the second return value if any interval is found; otherwise, false.
+//
+// For more details on how relations are determined, see the Relate function.
+func (st *SearchTree[V, T]) Related(start, end T, rels ...Relation) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Related(start, end, rels...)
+}
+
+// Related returns a slice of values which interval key relates to the given start and end interval
+// by any of the given relations, according to Allen's interval algebra.
+// It returns true as the second return value if any interval is found; otherwise, false.
+//
+// For more details on how relations are determined, see the Relate function.
+func (st *SearchTreeView[V, T]) Related(start, end T, rels ...Relation) ([]V, bool) {
+	var vals []V
+	live := st.live()
+	related(st.root, newRelationQuery(start, end, rels, st.cmp), st.cmp, func(it interval[V, T]) {
+		if live(it) {
+			vals = append(vals, it.Val)
+		}
+	})
+
+	return vals, len(vals) > 0
+}
+
+// Related returns a slice of values which interval key relates to the given start and end interval
+// by any of the given relations, according to Allen's interval algebra.
+// It returns true as the second return value if any interval is found; otherwise, false.
+//
+// For more details on how relations are determined, see the Relate function.
+func (st *MultiValueSearchTree[V, T]) Related(start, end T, rels ...Relation) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Related(start, end, rels...)
+}
+
+// Related returns a slice of values which interval key relates to the given start and end interval
+// by any of the given relations, according to Allen's interval algebra.
+// It returns true as the second return value if any interval is found; otherwise, false.
+//
+// For more details on how relations are determined, see the Relate function.
+func (st *MultiValueSearchTreeView[V, T]) Related(start, end T, rels ...Relation) ([]V, bool) {
+	var vals []V
+	related(st.root, newRelationQuery(start, end, rels, st.cmp), st.cmp, func(it interval[V, T]) {
+		vals = append(vals, it.Vals...)
+	})
+
+	return vals, len(vals) > 0
+}
+
+// endpoint is an optional endpoint value used to restrict searches in the tree.
+type endpoint[T any] struct {
+	val T
+	ok  bool
+}
+
+// relationQuery holds the lower and upper limits for the start and the lower limit for the end
+// of any interval that may satisfy one of the given relations, so that subtrees can be pruned.
+type relationQuery[T any] struct {
+	start, end       T
+	rels             uint16
+	startLo, startHi endpoint[T]
+	endLo            endpoint[T]
+}
+
+func newRelationQuery[T any](start, end T, rels []Relation, cmp CmpFunc[T]) relationQuery[T] {
+	q := relationQuery[T]{start: start, end: end}
+	if len(rels) == 0 {
+		return q
+	}
+
+	none := endpoint[T]{}
+	s, e := endpoint[T]{val: start, ok: true}, endpoint[T]{val: end, ok: true}
+
+	// The limits of each relation, given that it must also hold that x.start <= x.end.
+	limits := [...][3]endpoint[T]{
+		Before:       {none, s, none},
+		Meets:        {none, s, s},
+		Overlaps:     {none, s, s},
+		Starts:       {s, s, s},
+		During:       {s, e, s},
+		Finishes:     {s, e, e},
+		Equals:       {s, s, e},
+		FinishedBy:   {none, s, e},
+		Contains:     {none, s, e},
+		StartedBy:    {s, s, e},
+		OverlappedBy: {s, e, e},
+		MetBy:        {e, e, e},
+		After:        {e, none, e},
+	}
+
+	lower := func(x, y endpoint[T]) endpoint[T] {
+		if !x.ok || !y.ok {
+			return none
+		}
+		if cmp.lt(y.val, x.val) {
+			return y
+		}
+		return x
+	}
+
+	upper := func(x, y endpoint[T]) endpoint[T] {
+		if !x.ok || !y.ok {
+			return none
+		}
+		if cmp.gt(y.val, x.val) {
+			return y
+		}
+		return x
+	}
+
+	for _, r := range rels {
+		if int(r) >= len(limits) {
+			continue
+		}
+
+		l := limits[r]
+		if q.rels == 0 {
+			q.startLo, q.startHi, q.endLo = l[0], l[1], l[2]
+		} else {
+			q.startLo, q.startHi, q.endLo = lower(q.startLo, l[0]), upper(q.startHi, l[1]), lower(q.endLo, l[2])
+		}
+		q.rels |= 1 << r
+	}
+
+	return q
+}
+
+func (q relationQuery[T]) matches(start, end T, cmp CmpFunc[T]) bool {
+	return q.rels&(1<<Relate(start, end, q.start, q.end, cmp)) != 0
+}
+
+func related[V, T any](n *node[V, T], q relationQuery[T], cmp CmpFunc[T], foundFn func(interval[V, T])) {
+	if n == nil || q.rels == 0 || q.endLo.ok && cmp.lt(n.MaxEnd, q.endLo.val) {
+		return
+	}
+
+	// Intervals in the left subtree can't start after this one.
+	if !q.startLo.ok || cmp.gte(n.Interval.Start, q.startLo.val) {
+		related(n.Left, q, cmp, foundFn)
+	}
+
+	if q.matches(n.Interval.Start, n.Interval.End, cmp) {
+		foundFn(n.Interval)
+	}
+
+	// Intervals in the right subtree can't start before this one.
+	if !q.startHi.ok || cmp.lte(n.Interval.Start, q.startHi.val) {
+		related(n.Right, q, cmp, foundFn)
+	}
+}
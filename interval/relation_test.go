@@ -0,0 +1,156 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestRelate(t *testing.T) {
+	cmp := func(x, y int) int { return x - y }
+
+	testCases := []struct {
+		x, y [2]int
+		want Relation
+	}{
+		{x: [2]int{1, 3}, y: [2]int{4, 6}, want: Before},
+		{x: [2]int{1, 4}, y: [2]int{4, 6}, want: Meets},
+		{x: [2]int{1, 5}, y: [2]int{4, 6}, want: Overlaps},
+		{x: [2]int{4, 5}, y: [2]int{4, 6}, want: Starts},
+		{x: [2]int{5, 6}, y: [2]int{4, 7}, want: During},
+		{x: [2]int{5, 7}, y: [2]int{4, 7}, want: Finishes},
+		{x: [2]int{4, 7}, y: [2]int{4, 7}, want: Equals},
+		{x: [2]int{3, 7}, y: [2]int{4, 7}, want: FinishedBy},
+		{x: [2]int{3, 8}, y: [2]int{4, 7}, want: Contains},
+		{x: [2]int{4, 8}, y: [2]int{4, 7}, want: StartedBy},
+		{x: [2]int{5, 8}, y: [2]int{4, 7}, want: OverlappedBy},
+		{x: [2]int{7, 8}, y: [2]int{4, 7}, want: MetBy},
+		{x: [2]int{8, 9}, y: [2]int{4, 7}, want: After},
+		{x: [2]int{4, 4}, y: [2]int{4, 4}, want: Equals},
+		{x: [2]int{4, 4}, y: [2]int{4, 7}, want: Meets},
+		{x: [2]int{7, 7}, y: [2]int{4, 7}, want: MetBy},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want.String(), func(t *testing.T) {
+			got := Relate(tc.x[0], tc.x[1], tc.y[0], tc.y[1], cmp)
+			if got != tc.want {
+				t.Errorf("Relate(%v, %v, %v, %v): got unexpected relation %v; want %v", tc.x[0], tc.x[1], tc.y[0], tc.y[1], got, tc.want)
+			}
+
+			if tc.x[0] == tc.x[1] {
+				return
+			}
+
+			got = Relate(tc.y[0], tc.y[1], tc.x[0], tc.x[1], cmp)
+			if want := tc.want.Inverse(); got != want {
+				t.Errorf("Relate(%v, %v, %v, %v): got unexpected relation %v; want %v", tc.y[0], tc.y[1], tc.x[0], tc.x[1], got, want)
+			}
+		})
+	}
+}
+
+func TestRelation_String(t *testing.T) {
+	if got, want := OverlappedBy.String(), "overlapped-by"; got != want {
+		t.Errorf("OverlappedBy.String(): got unexpected value %q; want %q", got, want)
+	}
+
+	if got, want := Relation(20).String(), "Relation(20)"; got != want {
+		t.Errorf("Relation(20).String(): got unexpected value %q; want %q", got, want)
+	}
+}
+
+func TestSearchTree_Related(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 3, "node1")
+	st.Insert(1, 4, "node2")
+	st.Insert(4, 5, "node3")
+	st.Insert(5, 6, "node4")
+	st.Insert(4, 7, "node5")
+	st.Insert(6, 7, "node6")
+	st.Insert(7, 9, "node7")
+	st.Insert(3, 8, "node8")
+
+	testCases := []struct {
+		name     string
+		rels     []Relation
+		wantVals []string
+	}{
+		{name: "Before", rels: []Relation{Before}, wantVals: []string{"node1"}},
+		{name: "MeetsOrMetBy", rels: []Relation{Meets, MetBy}, wantVals: []string{"node2", "node7"}},
+		{name: "During", rels: []Relation{During}, wantVals: []string{"node4"}},
+		{name: "StartsOrFinishes", rels: []Relation{Starts, Finishes}, wantVals: []string{"node3", "node6"}},
+		{name: "Equals", rels: []Relation{Equals}, wantVals: []string{"node5"}},
+		{name: "Contains", rels: []Relation{Contains}, wantVals: []string{"node8"}},
+		{name: "After", rels: []Relation{After}},
+		{name: "None"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := st.Related(4, 7, tc.rels...)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.Related(4, 7, %v): got ok value %t; want %t", tc.rels, ok, want)
+			}
+
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.Related(4, 7, %v): got unexpected value %v; want %v", tc.rels, got, tc.wantVals)
+			}
+		})
+	}
+}
+
+func TestSearchTree_Related_Random(t *testing.T) {
+	cmp := func(x, y int) int { return x - y }
+	st := NewSearchTreeWithOptions[int](cmp, TreeWithIntervalPoint())
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		start := r.Intn(100)
+		st.Insert(start, start+r.Intn(20), i)
+	}
+
+	for i := 0; i < 300; i++ {
+		start := r.Intn(100)
+		end := start + r.Intn(30)
+
+		var rels []Relation
+		set := make(map[Relation]bool)
+		for j := r.Intn(4); j >= 0; j-- {
+			rel := Relation(r.Intn(13))
+			rels = append(rels, rel)
+			set[rel] = true
+		}
+
+		var want []int
+		for _, e := range st.All() {
+			if set[Relate(e.Start, e.End, start, end, cmp)] {
+				want = append(want, e.Val)
+			}
+		}
+
+		if got, _ := st.Related(start, end, rels...); !reflect.DeepEqual(got, want) {
+			t.Fatalf("st.Related(%v, %v, %v): got unexpected value %v; want %v", start, end, rels, got, want)
+		}
+	}
+}
+
+func TestMultiValueSearchTree_Related(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 4, "node1", "node2")
+	st.Insert(4, 5, "node3")
+	st.Insert(5, 9, "node4")
+
+	got, ok := st.Related(4, 7, Meets, Starts)
+	if want := []string{"node1", "node2", "node3"}; !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Related(4, 7, Meets, Starts): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, ok := st.Related(4, 7, Equals); ok {
+		t.Errorf("st.Related(4, 7, Equals): got unexpected value %v", got)
+	}
+}
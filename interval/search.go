@@ -7,10 +7,17 @@ func (st *SearchTree[V, T]) Find(start, end T) (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Find(start, end)
+}
+
+// Find returns the value which interval key exactly matches with the given start and end interval.
+// It returns true as the second return value if an exaclty matching interval key is found in the tree;
+// otherwise, false.
+func (st *SearchTreeView[V, T]) Find(start, end T) (V, bool) {
 	var val V
 
 	interval, ok := find(st.root, start, end, st.cmp)
-	if !ok {
+	if !ok || !st.live()(interval) {
 		return val, false
 	}
 
@@ -43,9 +50,15 @@ func (st *SearchTree[V, T]) AnyIntersection(start, end T) (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().AnyIntersection(start, end)
+}
+
+// AnyIntersection returns a value which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) AnyIntersection(start, end T) (V, bool) {
 	var val V
 
-	interval, ok := anyIntersections(st.root, start, end, st.cmp)
+	interval, ok := st.anyIntersection(start, end)
 	if !ok {
 		return val, false
 	}
@@ -53,26 +66,63 @@ func (st *SearchTree[V, T]) AnyIntersection(start, end T) (V, bool) {
 	return interval.Val, true
 }
 
-func anyIntersections[V, T any](root *node[V, T], start, end T, cmp CmpFunc[T]) (interval[V, T], bool) {
-	if root == nil {
+// AnyIntersectionEntry returns an entry which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTree[V, T]) AnyIntersectionEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AnyIntersectionEntry(start, end)
+}
+
+// AnyIntersectionEntry returns an entry which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) AnyIntersectionEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := st.anyIntersection(start, end)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
+// anyIntersection returns the smallest interval in the tree that intersects with the given start and end interval
+// and hasn't expired.
+func (st *SearchTreeView[V, T]) anyIntersection(start, end T) (interval[V, T], bool) {
+	if st.root == nil {
 		return interval[V, T]{}, false
 	}
 
-	cur := root
-	for cur != nil {
-		if cur.Interval.intersects(start, end, cmp) {
-			return cur.Interval, true
-		}
+	var (
+		found interval[V, T]
+		ok    bool
+	)
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, st.unexpired(func(_ int, it interval[V, T]) bool {
+		found, ok = it, true
+		return false
+	}))
 
-		next := cur.Left
-		if cur.Left == nil || cmp.gt(start, cur.Left.MaxEnd) {
-			next = cur.Right
-		}
+	return found, ok
+}
 
-		cur = next
+// anyIntersections returns the smallest interval in the tree that intersects with the given start and end interval.
+// As intervals may have different bounds, the search can't just follow a single path from the root;
+// instead, it stops at the first intersection found in order.
+func anyIntersections[V, T any](root *node[V, T], start, end T, b Bounds, cmp CmpFunc[T]) (interval[V, T], bool) {
+	if root == nil {
+		return interval[V, T]{}, false
 	}
 
-	return interval[V, T]{}, false
+	var (
+		found interval[V, T]
+		ok    bool
+	)
+	searchInOrder(root, 0, start, end, b, cmp, func(_ int, it interval[V, T]) bool {
+		found, ok = it, true
+		return false
+	})
+
+	return found, ok
 }
 
 // AllIntersections returns a slice of values which interval key intersects with the given start and end interval.
@@ -81,30 +131,75 @@ func (st *SearchTree[V, T]) AllIntersections(start, end T) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().AllIntersections(start, end)
+}
+
+// AllIntersections returns a slice of values which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) AllIntersections(start, end T) ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
 	}
 
-	searchInOrder(st.root, start, end, st.cmp, func(it interval[V, T]) {
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, st.unexpired(func(_ int, it interval[V, T]) bool {
 		vals = append(vals, it.Val)
-	})
+		return true
+	}))
 
 	return vals, len(vals) > 0
 }
 
-func searchInOrder[V, T any](n *node[V, T], start, end T, cmp CmpFunc[T], foundFn func(interval[V, T])) {
-	if n.Left != nil && cmp.lte(start, n.Left.MaxEnd) {
-		searchInOrder(n.Left, start, end, cmp, foundFn)
+// AllIntersectionEntries returns a slice of entries which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTree[V, T]) AllIntersectionEntries(start, end T) ([]Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AllIntersectionEntries(start, end)
+}
+
+// AllIntersectionEntries returns a slice of entries which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) AllIntersectionEntries(start, end T) ([]Entry[V, T], bool) {
+	var entries []Entry[V, T]
+	if st.root == nil {
+		return entries, false
 	}
 
-	if n.Interval.intersects(start, end, cmp) {
-		foundFn(n.Interval)
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, st.unexpired(func(_ int, it interval[V, T]) bool {
+		entries = append(entries, it.entry())
+		return true
+	}))
+
+	return entries, len(entries) > 0
+}
+
+// searchInOrder calls foundFn in order with the rank and the interval of every intersection found in n,
+// where offset is the rank of the smallest interval in n. It returns false if foundFn stopped the search.
+func searchInOrder[V, T any](n *node[V, T], offset int, start, end T, b Bounds, cmp CmpFunc[T], foundFn func(int, interval[V, T]) bool) bool {
+	q := interval[V, T]{Start: start, End: end, Bounds: b}
+
+	// The max end of the left subtree and the start of the right subtree are
+	// assumed to be included in their intervals, as bounds may vary per interval.
+	if n.Left != nil && (cmp.lt(start, n.Left.MaxEnd) || cmp.eq(start, n.Left.MaxEnd) && q.includesStart(cmp)) {
+		if !searchInOrder(n.Left, offset, start, end, b, cmp, foundFn) {
+			return false
+		}
 	}
 
-	if n.Right != nil && cmp.lte(n.Interval.Start, end) {
-		searchInOrder(n.Right, start, end, cmp, foundFn)
+	offset += size(n.Left)
+	if n.Interval.intersects(start, end, b, cmp) {
+		if !foundFn(offset, n.Interval) {
+			return false
+		}
 	}
+
+	if n.Right != nil && (cmp.lt(n.Interval.Start, end) || cmp.eq(n.Interval.Start, end) && q.includesEnd(cmp)) {
+		return searchInOrder(n.Right, offset+1, start, end, b, cmp, foundFn)
+	}
+
+	return true
 }
 
 // Min returns the value which interval key is the minimum interval key in the tree.
@@ -113,6 +208,12 @@ func (st *SearchTree[V, T]) Min() (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Min()
+}
+
+// Min returns the value which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) Min() (V, bool) {
 	var val V
 	if st.root == nil {
 		return val, false
@@ -123,12 +224,37 @@ func (st *SearchTree[V, T]) Min() (V, bool) {
 	return val, true
 }
 
+// MinEntry returns the entry which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTree[V, T]) MinEntry() (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MinEntry()
+}
+
+// MinEntry returns the entry which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) MinEntry() (Entry[V, T], bool) {
+	if st.root == nil {
+		return Entry[V, T]{}, false
+	}
+
+	return min(st.root).Interval.entry(), true
+}
+
 // Max returns the value which interval key is the maximum interval in the tree.
 // It returns false as the second return value if the tree is empty; otherwise, true.
 func (st *SearchTree[V, T]) Max() (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Max()
+}
+
+// Max returns the value which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) Max() (V, bool) {
 	var val V
 	if st.root == nil {
 		return val, false
@@ -139,12 +265,37 @@ func (st *SearchTree[V, T]) Max() (V, bool) {
 	return val, true
 }
 
+// MaxEntry returns the entry which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTree[V, T]) MaxEntry() (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxEntry()
+}
+
+// MaxEntry returns the entry which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) MaxEntry() (Entry[V, T], bool) {
+	if st.root == nil {
+		return Entry[V, T]{}, false
+	}
+
+	return max(st.root).Interval.entry(), true
+}
+
 // MaxEnd returns the values in the tree that have the largest ending interval.
 // It returns false as the second return value if the tree is empty; otherwise, true.
 func (st *SearchTree[V, T]) MaxEnd() ([]V, bool) {
-	st.mu.Lock()
-	defer st.mu.Unlock()
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxEnd()
+}
 
+// MaxEnd returns the values in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) MaxEnd() ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
@@ -156,6 +307,29 @@ func (st *SearchTree[V, T]) MaxEnd() ([]V, bool) {
 	return vals, true
 }
 
+// MaxEndEntries returns the entries in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTree[V, T]) MaxEndEntries() ([]Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxEndEntries()
+}
+
+// MaxEndEntries returns the entries in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *SearchTreeView[V, T]) MaxEndEntries() ([]Entry[V, T], bool) {
+	var entries []Entry[V, T]
+	if st.root == nil {
+		return entries, false
+	}
+
+	maxEnd(st.root, st.root.MaxEnd, st.cmp, func(n *node[V, T]) {
+		entries = append(entries, n.Interval.entry())
+	})
+	return entries, true
+}
+
 // Ceil returns a value which interval key is the smallest interval key greater than the given start and end interval.
 // It returns true as the second return value if there's a ceiling interval key for the given start and end interval
 // in the tree; otherwise, false.
@@ -163,6 +337,13 @@ func (st *SearchTree[V, T]) Ceil(start, end T) (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Ceil(start, end)
+}
+
+// Ceil returns a value which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) Ceil(start, end T) (V, bool) {
 	var val V
 	interval, ok := ceil(st.root, start, end, st.cmp)
 	if !ok {
@@ -172,6 +353,28 @@ func (st *SearchTree[V, T]) Ceil(start, end T) (V, bool) {
 	return interval.Val, true
 }
 
+// CeilEntry returns an entry which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTree[V, T]) CeilEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CeilEntry(start, end)
+}
+
+// CeilEntry returns an entry which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) CeilEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := ceil(st.root, start, end, st.cmp)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 func ceil[V, T any](root *node[V, T], start, end T, cmp CmpFunc[T]) (interval[V, T], bool) {
 	if root == nil {
 		return interval[V, T]{}, false
@@ -207,6 +410,13 @@ func (st *SearchTree[V, T]) Floor(start, end T) (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Floor(start, end)
+}
+
+// Floor returns a value which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) Floor(start, end T) (V, bool) {
 	var val V
 	interval, ok := floor(st.root, start, end, st.cmp)
 	if !ok {
@@ -216,6 +426,28 @@ func (st *SearchTree[V, T]) Floor(start, end T) (V, bool) {
 	return interval.Val, true
 }
 
+// FloorEntry returns an entry which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTree[V, T]) FloorEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().FloorEntry(start, end)
+}
+
+// FloorEntry returns an entry which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *SearchTreeView[V, T]) FloorEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := floor(st.root, start, end, st.cmp)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 func floor[V, T any](root *node[V, T], start, end T, cmp CmpFunc[T]) (interval[V, T], bool) {
 	if root == nil {
 		return interval[V, T]{}, false
@@ -249,6 +481,11 @@ func (st *SearchTree[V, T]) Rank(start, end T) int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Rank(start, end)
+}
+
+// Rank returns the number of intervals strictly less than the given start and end interval.
+func (st *SearchTreeView[V, T]) Rank(start, end T) int {
 	return rank(st.root, start, end, st.cmp)
 }
 
@@ -278,6 +515,13 @@ func (st *SearchTree[V, T]) Select(k int) (V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Select(k)
+}
+
+// Select returns the value which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *SearchTreeView[V, T]) Select(k int) (V, bool) {
 	var val V
 
 	interval, ok := selectInterval(st.root, k)
@@ -288,6 +532,28 @@ func (st *SearchTree[V, T]) Select(k int) (V, bool) {
 	return interval.Val, true
 }
 
+// SelectEntry returns the entry which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *SearchTree[V, T]) SelectEntry(k int) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().SelectEntry(k)
+}
+
+// SelectEntry returns the entry which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *SearchTreeView[V, T]) SelectEntry(k int) (Entry[V, T], bool) {
+	interval, ok := selectInterval(st.root, k)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 func selectInterval[V, T any](root *node[V, T], k int) (interval[V, T], bool) {
 	cur := root
 	for cur != nil {
@@ -313,6 +579,13 @@ func (st *MultiValueSearchTree[V, T]) Find(start, end T) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Find(start, end)
+}
+
+// Find returns the values which interval key exactly matches with the given start and end interval.
+// It returns true as the second return value if an exaclty matching interval key is found in the tree;
+// otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) Find(start, end T) ([]V, bool) {
 	var vals []V
 
 	interval, ok := find(st.root, start, end, st.cmp)
@@ -329,7 +602,13 @@ func (st *MultiValueSearchTree[V, T]) AnyIntersection(start, end T) ([]V, bool)
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
-	interval, ok := anyIntersections(st.root, start, end, st.cmp)
+	return st.view().AnyIntersection(start, end)
+}
+
+// AnyIntersection returns values which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) AnyIntersection(start, end T) ([]V, bool) {
+	interval, ok := anyIntersections(st.root, start, end, st.config.bounds, st.cmp)
 	if !ok {
 		return nil, false
 	}
@@ -337,30 +616,92 @@ func (st *MultiValueSearchTree[V, T]) AnyIntersection(start, end T) ([]V, bool)
 	return interval.Vals, true
 }
 
+// AnyIntersectionEntry returns an entry which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) AnyIntersectionEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AnyIntersectionEntry(start, end)
+}
+
+// AnyIntersectionEntry returns an entry which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) AnyIntersectionEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := anyIntersections(st.root, start, end, st.config.bounds, st.cmp)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 // AllIntersections returns a slice of values which interval key intersects with the given start and end interval.
 // It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+//
+// To find out which values were found under which interval key, see AllIntersectionEntries.
 func (st *MultiValueSearchTree[V, T]) AllIntersections(start, end T) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().AllIntersections(start, end)
+}
+
+// AllIntersections returns a slice of values which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+//
+// To find out which values were found under which interval key, see AllIntersectionEntries.
+func (st *MultiValueSearchTreeView[V, T]) AllIntersections(start, end T) ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
 	}
 
-	searchInOrder(st.root, start, end, st.cmp, func(it interval[V, T]) {
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, func(_ int, it interval[V, T]) bool {
 		vals = append(vals, it.Vals...)
+		return true
 	})
 
 	return vals, len(vals) > 0
 }
 
+// AllIntersectionEntries returns a slice of entries which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) AllIntersectionEntries(start, end T) ([]Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AllIntersectionEntries(start, end)
+}
+
+// AllIntersectionEntries returns a slice of entries which interval key intersects with the given start and end interval.
+// It returns true as the second return value if any intersection is found in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) AllIntersectionEntries(start, end T) ([]Entry[V, T], bool) {
+	var entries []Entry[V, T]
+	if st.root == nil {
+		return entries, false
+	}
+
+	searchInOrder(st.root, 0, start, end, st.config.bounds, st.cmp, func(_ int, it interval[V, T]) bool {
+		entries = append(entries, it.entry())
+		return true
+	})
+
+	return entries, len(entries) > 0
+}
+
 // Min returns the values which interval key is the minimum interval key in the tree.
 // It returns false as the second return value if the tree is empty; otherwise, true.
 func (st *MultiValueSearchTree[V, T]) Min() ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Min()
+}
+
+// Min returns the values which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) Min() ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
@@ -371,12 +712,37 @@ func (st *MultiValueSearchTree[V, T]) Min() ([]V, bool) {
 	return vals, true
 }
 
+// MinEntry returns the entry which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTree[V, T]) MinEntry() (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MinEntry()
+}
+
+// MinEntry returns the entry which interval key is the minimum interval key in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) MinEntry() (Entry[V, T], bool) {
+	if st.root == nil {
+		return Entry[V, T]{}, false
+	}
+
+	return min(st.root).Interval.entry(), true
+}
+
 // Max returns the values which interval key is the maximum interval in the tree.
 // It returns false as the second return value if the tree is empty; otherwise, true.
 func (st *MultiValueSearchTree[V, T]) Max() ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Max()
+}
+
+// Max returns the values which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) Max() ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
@@ -387,6 +753,25 @@ func (st *MultiValueSearchTree[V, T]) Max() ([]V, bool) {
 	return vals, true
 }
 
+// MaxEntry returns the entry which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTree[V, T]) MaxEntry() (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxEntry()
+}
+
+// MaxEntry returns the entry which interval key is the maximum interval in the tree.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) MaxEntry() (Entry[V, T], bool) {
+	if st.root == nil {
+		return Entry[V, T]{}, false
+	}
+
+	return max(st.root).Interval.entry(), true
+}
+
 // Ceil returns the values which interval key is the smallest interval key greater than the given start and end interval.
 // It returns true as the second return value if there's a ceiling interval key for the given start and end interval
 // in the tree; otherwise, false.
@@ -394,6 +779,13 @@ func (st *MultiValueSearchTree[V, T]) Ceil(start, end T) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Ceil(start, end)
+}
+
+// Ceil returns the values which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) Ceil(start, end T) ([]V, bool) {
 	var vals []V
 	interval, ok := ceil(st.root, start, end, st.cmp)
 	if !ok {
@@ -403,6 +795,28 @@ func (st *MultiValueSearchTree[V, T]) Ceil(start, end T) ([]V, bool) {
 	return interval.Vals, true
 }
 
+// CeilEntry returns an entry which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) CeilEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CeilEntry(start, end)
+}
+
+// CeilEntry returns an entry which interval key is the smallest interval key greater than the given start and end interval.
+// It returns true as the second return value if there's a ceiling interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) CeilEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := ceil(st.root, start, end, st.cmp)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 // Floor returns the values which interval key is the greatest interval key lesser than the given start and end interval.
 // It returns true as the second return value if there's a floor interval key for the given start and end interval
 // in the tree; otherwise, false.
@@ -410,6 +824,13 @@ func (st *MultiValueSearchTree[V, T]) Floor(start, end T) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Floor(start, end)
+}
+
+// Floor returns the values which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) Floor(start, end T) ([]V, bool) {
 	var vals []V
 	interval, ok := floor(st.root, start, end, st.cmp)
 	if !ok {
@@ -419,11 +840,38 @@ func (st *MultiValueSearchTree[V, T]) Floor(start, end T) ([]V, bool) {
 	return interval.Vals, true
 }
 
+// FloorEntry returns an entry which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) FloorEntry(start, end T) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().FloorEntry(start, end)
+}
+
+// FloorEntry returns an entry which interval key is the greatest interval key lesser than the given start and end interval.
+// It returns true as the second return value if there's a floor interval key for the given start and end interval
+// in the tree; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) FloorEntry(start, end T) (Entry[V, T], bool) {
+	interval, ok := floor(st.root, start, end, st.cmp)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 // Rank returns the number of intervals strictly less than the given start and end interval.
 func (st *MultiValueSearchTree[V, T]) Rank(start, end T) int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Rank(start, end)
+}
+
+// Rank returns the number of intervals strictly less than the given start and end interval.
+func (st *MultiValueSearchTreeView[V, T]) Rank(start, end T) int {
 	return rank(st.root, start, end, st.cmp)
 }
 
@@ -434,6 +882,13 @@ func (st *MultiValueSearchTree[V, T]) Select(k int) ([]V, bool) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Select(k)
+}
+
+// Select returns the values which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) Select(k int) ([]V, bool) {
 	var vals []V
 
 	interval, ok := selectInterval(st.root, k)
@@ -444,12 +899,40 @@ func (st *MultiValueSearchTree[V, T]) Select(k int) ([]V, bool) {
 	return interval.Vals, true
 }
 
+// SelectEntry returns the entry which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *MultiValueSearchTree[V, T]) SelectEntry(k int) (Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().SelectEntry(k)
+}
+
+// SelectEntry returns the entry which interval key is the kth smallest interval key in the tree.
+// It returns false if k is not between 0 and N-1, where N is the number of interval keys
+// in the tree; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) SelectEntry(k int) (Entry[V, T], bool) {
+	interval, ok := selectInterval(st.root, k)
+	if !ok {
+		return Entry[V, T]{}, false
+	}
+
+	return interval.entry(), true
+}
+
 // MaxEnd returns the values in the tree that have the largest ending interval.
 // It returns false as the second return value if the tree is empty; otherwise, true.
 func (st *MultiValueSearchTree[V, T]) MaxEnd() ([]V, bool) {
-	st.mu.Lock()
-	defer st.mu.Unlock()
+	st.mu.RLock()
+	defer st.mu.RUnlock()
 
+	return st.view().MaxEnd()
+}
+
+// MaxEnd returns the values in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) MaxEnd() ([]V, bool) {
 	var vals []V
 	if st.root == nil {
 		return vals, false
@@ -461,6 +944,29 @@ func (st *MultiValueSearchTree[V, T]) MaxEnd() ([]V, bool) {
 	return vals, true
 }
 
+// MaxEndEntries returns the entries in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTree[V, T]) MaxEndEntries() ([]Entry[V, T], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().MaxEndEntries()
+}
+
+// MaxEndEntries returns the entries in the tree that have the largest ending interval.
+// It returns false as the second return value if the tree is empty; otherwise, true.
+func (st *MultiValueSearchTreeView[V, T]) MaxEndEntries() ([]Entry[V, T], bool) {
+	var entries []Entry[V, T]
+	if st.root == nil {
+		return entries, false
+	}
+
+	maxEnd(st.root, st.root.MaxEnd, st.cmp, func(n *node[V, T]) {
+		entries = append(entries, n.Interval.entry())
+	})
+	return entries, true
+}
+
 func maxEnd[V, T any](n *node[V, T], searchEnd T, cmp CmpFunc[T], visit func(*node[V, T])) {
 
 	// If this node's interval lines up with MaxEnd, visit it.
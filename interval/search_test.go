@@ -1115,3 +1115,288 @@ func TestMultiValueSearchTree_MaxEnd(t *testing.T) {
 	}
 
 }
+
+func TestSearchTree_Entries(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+
+	entry := func(start, end int, val string) Entry[string, int] {
+		return Entry[string, int]{Start: start, End: end, Val: val}
+	}
+
+	testCases := []struct {
+		name   string
+		query  func() (any, bool)
+		want   any
+		wantOK bool
+	}{
+		{
+			name:   "AnyIntersectionEntry",
+			query:  func() (any, bool) { return st.AnyIntersectionEntry(23, 25) },
+			want:   entry(21, 24, "node3"),
+			wantOK: true,
+		},
+		{
+			name:   "AnyIntersectionEntry/NotFound",
+			query:  func() (any, bool) { return st.AnyIntersectionEntry(12, 14) },
+			want:   Entry[string, int]{},
+			wantOK: false,
+		},
+		{
+			name:   "AllIntersectionEntries",
+			query:  func() (any, bool) { return st.AllIntersectionEntries(9, 16) },
+			want:   []Entry[string, int]{entry(7, 10, "node6"), entry(15, 18, "node5")},
+			wantOK: true,
+		},
+		{
+			name:   "AllIntersectionEntries/NotFound",
+			query:  func() (any, bool) { return st.AllIntersectionEntries(12, 14) },
+			want:   []Entry[string, int](nil),
+			wantOK: false,
+		},
+		{
+			name:   "MinEntry",
+			query:  func() (any, bool) { return st.MinEntry() },
+			want:   entry(4, 8, "node4"),
+			wantOK: true,
+		},
+		{
+			name:   "MaxEntry",
+			query:  func() (any, bool) { return st.MaxEntry() },
+			want:   entry(21, 24, "node3"),
+			wantOK: true,
+		},
+		{
+			name:   "MaxEndEntries",
+			query:  func() (any, bool) { return st.MaxEndEntries() },
+			want:   []Entry[string, int]{entry(21, 24, "node3")},
+			wantOK: true,
+		},
+		{
+			name:   "CeilEntry",
+			query:  func() (any, bool) { return st.CeilEntry(9, 16) },
+			want:   entry(15, 18, "node5"),
+			wantOK: true,
+		},
+		{
+			name:   "FloorEntry",
+			query:  func() (any, bool) { return st.FloorEntry(9, 16) },
+			want:   entry(7, 10, "node6"),
+			wantOK: true,
+		},
+		{
+			name:   "SelectEntry",
+			query:  func() (any, bool) { return st.SelectEntry(3) },
+			want:   entry(15, 18, "node5"),
+			wantOK: true,
+		},
+		{
+			name:   "SelectEntry/OutOfRange",
+			query:  func() (any, bool) { return st.SelectEntry(8) },
+			want:   Entry[string, int]{},
+			wantOK: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.query()
+			if ok != tc.wantOK {
+				t.Errorf("st.%s: got ok value %t; want %t", tc.name, ok, tc.wantOK)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("st.%s: got unexpected value %v; want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_Entries_EmptyTree(t *testing.T) {
+	st := NewSearchTree[any](func(x, y int) int { return x - y })
+
+	if got, ok := st.MinEntry(); ok {
+		t.Errorf("st.MinEntry(): got unexpected entry %v", got)
+	}
+
+	if got, ok := st.MaxEntry(); ok {
+		t.Errorf("st.MaxEntry(): got unexpected entry %v", got)
+	}
+
+	if got, ok := st.MaxEndEntries(); ok {
+		t.Errorf("st.MaxEndEntries(): got unexpected entries %v", got)
+	}
+
+	if got, ok := st.CeilEntry(1, 10); ok {
+		t.Errorf("st.CeilEntry(1, 10): got unexpected entry %v", got)
+	}
+
+	if got, ok := st.FloorEntry(1, 10); ok {
+		t.Errorf("st.FloorEntry(1, 10): got unexpected entry %v", got)
+	}
+}
+
+func TestMultiValueSearchTree_Entries(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3", "node4")
+	st.Insert(4, 8, "node5")
+	st.Insert(15, 18, "node6")
+	st.Insert(7, 10, "node7", "node8")
+
+	entry := func(start, end int, vals ...string) Entry[string, int] {
+		return Entry[string, int]{Start: start, End: end, Vals: vals}
+	}
+
+	testCases := []struct {
+		name   string
+		query  func() (any, bool)
+		want   any
+		wantOK bool
+	}{
+		{
+			name:   "AnyIntersectionEntry",
+			query:  func() (any, bool) { return st.AnyIntersectionEntry(23, 25) },
+			want:   entry(21, 24, "node3", "node4"),
+			wantOK: true,
+		},
+		{
+			name:   "AllIntersectionEntries",
+			query:  func() (any, bool) { return st.AllIntersectionEntries(9, 16) },
+			want:   []Entry[string, int]{entry(7, 10, "node7", "node8"), entry(15, 18, "node6")},
+			wantOK: true,
+		},
+		{
+			name:   "MinEntry",
+			query:  func() (any, bool) { return st.MinEntry() },
+			want:   entry(4, 8, "node5"),
+			wantOK: true,
+		},
+		{
+			name:   "MaxEntry",
+			query:  func() (any, bool) { return st.MaxEntry() },
+			want:   entry(21, 24, "node3", "node4"),
+			wantOK: true,
+		},
+		{
+			name:   "MaxEndEntries",
+			query:  func() (any, bool) { return st.MaxEndEntries() },
+			want:   []Entry[string, int]{entry(21, 24, "node3", "node4")},
+			wantOK: true,
+		},
+		{
+			name:   "CeilEntry",
+			query:  func() (any, bool) { return st.CeilEntry(9, 16) },
+			want:   entry(15, 18, "node6"),
+			wantOK: true,
+		},
+		{
+			name:   "FloorEntry",
+			query:  func() (any, bool) { return st.FloorEntry(9, 16) },
+			want:   entry(7, 10, "node7", "node8"),
+			wantOK: true,
+		},
+		{
+			name:   "SelectEntry",
+			query:  func() (any, bool) { return st.SelectEntry(0) },
+			want:   entry(4, 8, "node5"),
+			wantOK: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.query()
+			if ok != tc.wantOK {
+				t.Errorf("st.%s: got ok value %t; want %t", tc.name, ok, tc.wantOK)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("st.%s: got unexpected value %v; want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchTree_AllIntersections_Bounds(t *testing.T) {
+	testCases := []struct {
+		bounds   Bounds
+		start    int
+		end      int
+		wantVals []string
+	}{
+		{bounds: Closed, start: 5, end: 9, wantVals: []string{"node1", "node2", "node3"}},
+		{bounds: HalfOpen, start: 5, end: 9, wantVals: []string{"node2"}},
+		{bounds: LeftHalfOpen, start: 5, end: 9, wantVals: []string{"node2"}},
+		{bounds: Open, start: 5, end: 9, wantVals: []string{"node2"}},
+		{bounds: HalfOpen, start: 4, end: 5, wantVals: []string{"node1"}},
+		{bounds: LeftHalfOpen, start: 4, end: 5, wantVals: []string{"node1"}},
+		{bounds: HalfOpen, start: 9, end: 10, wantVals: []string{"node3"}},
+		{bounds: LeftHalfOpen, start: 9, end: 10, wantVals: []string{"node3"}},
+		{bounds: Open, start: 9, end: 11, wantVals: []string{"node3"}},
+		{bounds: HalfOpen, start: 9, end: 9, wantVals: []string{"node3"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.bounds, tc.start, tc.end), func(t *testing.T) {
+			st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(tc.bounds), TreeWithIntervalPoint())
+			defer mustBeValidTree(t, st.root)
+
+			st.Insert(1, 5, "node1")
+			st.Insert(5, 9, "node2")
+			st.Insert(9, 12, "node3")
+
+			got, _ := st.AllIntersections(tc.start, tc.end)
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.AllIntersections(%v, %v): got unexpected value %v; want %v", tc.start, tc.end, got, tc.wantVals)
+			}
+		})
+	}
+}
+
+func TestSearchTree_AnyIntersection_MixedBounds(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	// The subtree at the left of the root ends at the start of the search interval,
+	// but it doesn't include it, so the intersection must be found at the right of the root.
+	st.InsertWithBounds(2, 3, HalfOpen, "node1")
+	st.InsertWithBounds(1, 5, HalfOpen, "node2")
+	st.InsertWithBounds(4, 9, HalfOpen, "node3")
+
+	start, end := 5, 7
+	got, ok := st.AnyIntersection(start, end)
+	if !ok {
+		t.Fatalf("st.AnyIntersection(%v, %v): got no intersection", start, end)
+	}
+
+	if want := "node3"; got != want {
+		t.Errorf("st.AnyIntersection(%v, %v): got unexpected value %v; want %v", start, end, got, want)
+	}
+}
+
+func TestBounds_String(t *testing.T) {
+	testCases := map[Bounds]string{
+		Closed:       "closed",
+		HalfOpen:     "half-open",
+		LeftHalfOpen: "left-half-open",
+		Open:         "open",
+		Bounds(9):    "Bounds(9)",
+	}
+
+	for b, want := range testCases {
+		if got := b.String(); got != want {
+			t.Errorf("Bounds(%d).String(): got unexpected value %q; want %q", uint8(b), got, want)
+		}
+	}
+}
@@ -25,12 +25,18 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 )
 
 // TreeConfig contains configuration fields that are used to customize the behavior
 // of interval trees, specifically SearchTree and MultiValueSearchTree types.
 type TreeConfig struct {
 	allowIntervalPoint bool
+	bounds             Bounds
+	mergeAdjacent      bool
+	valueDepth         bool
+	valueEqual         any // EqualFunc of the value type
+	clock              func() time.Time
 }
 
 // TreeOption is a functional option type used to customize the behavior
@@ -45,6 +51,57 @@ func TreeWithIntervalPoint() TreeOption {
 	}
 }
 
+// TreeWithBounds returns a TreeOption function that configures an interval tree to use the given bounds
+// for the intervals inserted into the tree and for the intervals given to search for intersections.
+// By default, an interval tree uses Closed bounds, so that intervals sharing an endpoint intersect.
+// To insert an interval with different bounds than the ones configured for the tree, see the InsertWithBounds method.
+func TreeWithBounds(b Bounds) TreeOption {
+	return func(c *TreeConfig) {
+		c.bounds = b
+	}
+}
+
+// TreeWithAdjacentMerge returns a TreeOption function that configures an IntervalSet to merge adjacent ranges,
+// which have no point in common and no gap between them, such as [1, 3) and [3, 5).
+// By default, only overlapping ranges are merged.
+func TreeWithAdjacentMerge() TreeOption {
+	return func(c *TreeConfig) {
+		c.mergeAdjacent = true
+	}
+}
+
+// TreeWithValueDepth returns a TreeOption function that configures a MultiValueSearchTree to count
+// the values of the intervals, instead of the intervals themselves, in depth queries such as MaxDepth and DepthAt.
+func TreeWithValueDepth() TreeOption {
+	return func(c *TreeConfig) {
+		c.valueDepth = true
+	}
+}
+
+// TreeWithValueEquality returns a TreeOption function that configures a MultiValueSearchTree to store
+// the values of each interval key as a set, comparing them with the given equality function.
+// Inserting a value that is already stored under the interval key, or the same value more than once,
+// becomes a no-op that returns a DuplicateValueError. The same applies to Upsert, Load, InsertBatch and Apply,
+// which leave the tree unchanged. Only the set operations, such as Union, merge the values of both trees
+// keeping a single copy of equal values.
+//
+// The type V must be the value type of the tree; otherwise, NewMultiValueSearchTreeWithOptions
+// and NewMultiValueSearchTreeFromSorted will panic. Other constructors, such as NewSearchTreeWithOptions,
+// will panic if given this option, since only a MultiValueSearchTree stores more than one value per interval key.
+func TreeWithValueEquality[V any](fn EqualFunc[V]) TreeOption {
+	return func(c *TreeConfig) {
+		c.valueEqual = fn
+	}
+}
+
+// TreeWithClock returns a TreeOption function that configures a SearchTree to read the current time
+// from the given clock, instead of time.Now, to tell when the entries inserted with InsertWithTTL expire.
+func TreeWithClock(now func() time.Time) TreeOption {
+	return func(c *TreeConfig) {
+		c.clock = now
+	}
+}
+
 // TypeMismatchError represents an error that occurs when a type mismatch
 // is encountered during the decoding of a tree from its gob representation.
 // It indicates that the encoded value does not match the expected type.
@@ -88,7 +145,7 @@ func NewSearchTree[V, T any](cmp CmpFunc[T]) *SearchTree[V, T] {
 // The opts parameter is an optional list of TreeOptions that customize the behavior of the tree,
 // such as allowing point intervals using TreeWithIntervalPoint.
 //
-// NewSearchTreeWithOptions will panic if cmp is nil.
+// NewSearchTreeWithOptions will panic if cmp is nil, or if opts include TreeWithValueEquality.
 func NewSearchTreeWithOptions[V, T any](cmp CmpFunc[T], opts ...TreeOption) *SearchTree[V, T] {
 	if cmp == nil {
 		panic("NewSearchTreeWithOptions: comparison function cmp cannot be nil")
@@ -102,6 +159,10 @@ func NewSearchTreeWithOptions[V, T any](cmp CmpFunc[T], opts ...TreeOption) *Sea
 		opt(&st.config)
 	}
 
+	if st.config.valueEqual != nil {
+		panic("NewSearchTreeWithOptions: TreeWithValueEquality is only supported by MultiValueSearchTree")
+	}
+
 	return st
 }
 
@@ -110,6 +171,11 @@ func (st *SearchTree[V, T]) Height() int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Height()
+}
+
+// Height returns the max depth of the tree.
+func (st *SearchTreeView[V, T]) Height() int {
 	return int(height(st.root))
 }
 
@@ -118,6 +184,11 @@ func (st *SearchTree[V, T]) Size() int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Size()
+}
+
+// Size returns the number of intervals in the tree.
+func (st *SearchTreeView[V, T]) Size() int {
 	return size(st.root)
 }
 
@@ -126,6 +197,11 @@ func (st *SearchTree[V, T]) IsEmpty() bool {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().IsEmpty()
+}
+
+// IsEmpty returns true if the tree is empty; otherwise, false.
+func (st *SearchTreeView[V, T]) IsEmpty() bool {
 	return st.root == nil
 }
 
@@ -134,24 +210,7 @@ func (st *SearchTree[V, T]) GobEncode() ([]byte, error) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
-	var b bytes.Buffer
-	enc := gob.NewEncoder(&b)
-
-	if err := enc.Encode(st.typeName()); err != nil {
-		return nil, err
-	}
-
-	if err := enc.Encode(st.config.allowIntervalPoint); err != nil {
-		return nil, err
-	}
-
-	if st.root != nil {
-		if err := enc.Encode(st.root); err != nil {
-			return nil, err
-		}
-	}
-
-	return b.Bytes(), nil
+	return gobEncode(st.typeName(), st.root, st.config)
 }
 
 // GobDecode decodes the tree (compatible with [encoding/gob]).
@@ -159,35 +218,7 @@ func (st *SearchTree[V, T]) GobDecode(data []byte) error {
 	st.mu.Lock()
 	defer st.mu.Unlock()
 
-	b := bytes.NewBuffer(data)
-	enc := gob.NewDecoder(b)
-
-	var typeName string
-	wantTypeName := st.typeName()
-
-	if err := enc.Decode(&typeName); err != nil {
-		return err
-	}
-
-	if typeName != wantTypeName {
-		return TypeMismatchError{from: typeName, to: wantTypeName}
-	}
-
-	if err := enc.Decode(&st.config.allowIntervalPoint); err != nil {
-		return err
-	}
-
-	if err := enc.Decode(&st.root); err != nil {
-		if err != io.EOF {
-			return err
-		}
-
-		// An EOF error implies that the root
-		// wasn't encoded because it was nil
-		st.root = nil
-	}
-
-	return nil
+	return gobDecode(data, st.typeName(), &st.root, &st.config)
 }
 
 func (st *SearchTree[V, T]) typeName() string {
@@ -219,7 +250,8 @@ func NewMultiValueSearchTree[V, T any](cmp CmpFunc[T]) *MultiValueSearchTree[V,
 // The opts parameter is an optional list of TreeOptions that customize the behavior of the tree,
 // such as allowing point intervals using TreeWithIntervalPoint.
 //
-// NewMultiValueSearchTreeWithOptions will panic if cmp is nil.
+// NewMultiValueSearchTreeWithOptions will panic if cmp is nil,
+// or if opts include TreeWithValueEquality for a type other than the value type V.
 func NewMultiValueSearchTreeWithOptions[V, T any](cmp CmpFunc[T], opts ...TreeOption) *MultiValueSearchTree[V, T] {
 	if cmp == nil {
 		panic("NewMultiValueSearchTreeWithOptions: comparison function cmp cannot be nil")
@@ -233,6 +265,10 @@ func NewMultiValueSearchTreeWithOptions[V, T any](cmp CmpFunc[T], opts ...TreeOp
 		opt(&st.config)
 	}
 
+	if _, ok := st.config.valueEqual.(EqualFunc[V]); st.config.valueEqual != nil && !ok {
+		panic(fmt.Sprintf("NewMultiValueSearchTreeWithOptions: TreeWithValueEquality configured with %T instead of an EqualFunc of the value type of the tree", st.config.valueEqual))
+	}
+
 	return st
 }
 
@@ -241,6 +277,11 @@ func (st *MultiValueSearchTree[V, T]) Height() int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Height()
+}
+
+// Height returns the max depth of the tree.
+func (st *MultiValueSearchTreeView[V, T]) Height() int {
 	return int(height(st.root))
 }
 
@@ -249,6 +290,11 @@ func (st *MultiValueSearchTree[V, T]) Size() int {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().Size()
+}
+
+// Size returns the number of intervals in the tree.
+func (st *MultiValueSearchTreeView[V, T]) Size() int {
 	return size(st.root)
 }
 
@@ -257,6 +303,11 @@ func (st *MultiValueSearchTree[V, T]) IsEmpty() bool {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return st.view().IsEmpty()
+}
+
+// IsEmpty returns true if the tree is empty; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) IsEmpty() bool {
 	return st.root == nil
 }
 
@@ -265,19 +316,45 @@ func (st *MultiValueSearchTree[V, T]) GobEncode() ([]byte, error) {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
 
+	return gobEncode(st.typeName(), st.root, st.config)
+}
+
+// GobDecode decodes the tree (compatible with [encoding/gob]).
+func (st *MultiValueSearchTree[V, T]) GobDecode(data []byte) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return gobDecode(data, st.typeName(), &st.root, &st.config)
+}
+
+func (st *MultiValueSearchTree[V, T]) typeName() string {
+	return "MultiValueSearchTree"
+}
+
+// gobConfig is the gob representation of a TreeConfig.
+type gobConfig struct {
+	AllowIntervalPoint bool
+	Bounds             Bounds
+}
+
+func gobEncode[V, T any](typeName string, root *node[V, T], config TreeConfig) ([]byte, error) {
 	var b bytes.Buffer
 	enc := gob.NewEncoder(&b)
 
-	if err := enc.Encode(st.typeName()); err != nil {
+	if err := enc.Encode(typeName); err != nil {
 		return nil, err
 	}
 
-	if err := enc.Encode(st.config.allowIntervalPoint); err != nil {
+	cfg := gobConfig{
+		AllowIntervalPoint: config.allowIntervalPoint,
+		Bounds:             config.bounds,
+	}
+	if err := enc.Encode(cfg); err != nil {
 		return nil, err
 	}
 
-	if st.root != nil {
-		if err := enc.Encode(st.root); err != nil {
+	if root != nil {
+		if err := enc.Encode(root); err != nil {
 			return nil, err
 		}
 	}
@@ -285,42 +362,80 @@ func (st *MultiValueSearchTree[V, T]) GobEncode() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-// GobDecode decodes the tree (compatible with [encoding/gob]).
-func (st *MultiValueSearchTree[V, T]) GobDecode(data []byte) error {
-	st.mu.Lock()
-	defer st.mu.Unlock()
+func gobDecode[V, T any](data []byte, wantTypeName string, root **node[V, T], config *TreeConfig) error {
+	dec := gob.NewDecoder(bytes.NewBuffer(data))
 
-	b := bytes.NewBuffer(data)
-	enc := gob.NewDecoder(b)
+	if err := decodeTypeName(dec, wantTypeName); err != nil {
+		return err
+	}
 
-	var typeName string
-	wantTypeName := st.typeName()
+	var cfg gobConfig
+	if err := dec.Decode(&cfg); err != nil {
+		// Trees encoded before the bounds were introduced
+		// only have the allowIntervalPoint flag as config.
+		return gobDecodeLegacy(data, wantTypeName, root, config)
+	}
 
-	if err := enc.Decode(&typeName); err != nil {
+	n, err := decodeRoot[V, T](dec)
+	if err != nil {
 		return err
 	}
 
-	if typeName != wantTypeName {
-		return TypeMismatchError{from: typeName, to: wantTypeName}
+	*root = n
+	config.allowIntervalPoint = cfg.AllowIntervalPoint
+	config.bounds = cfg.Bounds
+
+	return nil
+}
+
+func gobDecodeLegacy[V, T any](data []byte, wantTypeName string, root **node[V, T], config *TreeConfig) error {
+	dec := gob.NewDecoder(bytes.NewBuffer(data))
+
+	if err := decodeTypeName(dec, wantTypeName); err != nil {
+		return err
 	}
 
-	if err := enc.Decode(&st.config.allowIntervalPoint); err != nil {
+	var allowIntervalPoint bool
+	if err := dec.Decode(&allowIntervalPoint); err != nil {
 		return err
 	}
 
-	if err := enc.Decode(&st.root); err != nil {
+	n, err := decodeRoot[V, T](dec)
+	if err != nil {
+		return err
+	}
+
+	*root = n
+	config.allowIntervalPoint = allowIntervalPoint
+	config.bounds = Closed
+
+	return nil
+}
+
+func decodeTypeName(dec *gob.Decoder, wantTypeName string) error {
+	var typeName string
+	if err := dec.Decode(&typeName); err != nil {
+		return err
+	}
+
+	if typeName != wantTypeName {
+		return TypeMismatchError{from: typeName, to: wantTypeName}
+	}
+
+	return nil
+}
+
+func decodeRoot[V, T any](dec *gob.Decoder) (*node[V, T], error) {
+	var root *node[V, T]
+	if err := dec.Decode(&root); err != nil {
 		if err != io.EOF {
-			return err
+			return nil, err
 		}
 
 		// An EOF error implies that the root
 		// wasn't encoded because it was nil
-		st.root = nil
+		return nil, nil
 	}
 
-	return nil
-}
-
-func (st *MultiValueSearchTree[V, T]) typeName() string {
-	return "MultiValueSearchTree"
+	return root, nil
 }
@@ -33,6 +33,16 @@ func TestNewSearchTreeWithOptions_EmptyCmp(t *testing.T) {
 	NewSearchTreeWithOptions[string, int](nil)
 }
 
+func TestNewSearchTreeWithOptions_ValueEquality(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("NewSearchTreeWithOptions(cmp, TreeWithValueEquality(strings.EqualFold)): got execution without panic")
+		}
+	}()
+
+	NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(strings.EqualFold))
+}
+
 func TestSearchTree_Height(t *testing.T) {
 	st := NewSearchTree[int](func(x, y int) int { return x - y })
 
@@ -161,6 +171,16 @@ func TestMultiValueSearchTreeWithOptions_NilCmpFunc(t *testing.T) {
 	NewMultiValueSearchTreeWithOptions[string, int](nil)
 }
 
+func TestMultiValueSearchTreeWithOptions_ValueEqualityType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("NewMultiValueSearchTreeWithOptions(cmp, TreeWithValueEquality[int](eq)): got execution without panic")
+		}
+	}()
+
+	NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(func(x, y int) bool { return x == y }))
+}
+
 func TestMultiValueSearchTree_IsEmpty(t *testing.T) {
 	st := NewMultiValueSearchTree[int](func(x, y int) int { return x - y })
 
@@ -222,6 +242,23 @@ func TestSearchTree_EncodingDecoding(t *testing.T) {
 				return NewSearchTreeWithOptions[string, int](func(x, y int) int { return x - y }, TreeWithIntervalPoint())
 			},
 		},
+		{
+			name: "with bounds",
+			tree: func() *SearchTree[string, int] {
+				st := NewSearchTreeWithOptions[string, int](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+				st.Insert(17, 19, "node1")
+				st.Insert(5, 8, "node2")
+				st.InsertWithBounds(21, 24, Open, "node3")
+
+				return st
+			},
+		},
+		{
+			name: "with bounds & empty",
+			tree: func() *SearchTree[string, int] {
+				return NewSearchTreeWithOptions[string, int](func(x, y int) int { return x - y }, TreeWithBounds(Open))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -266,6 +303,35 @@ func mustTestSearchTree_EncodingDecoding(t *testing.T, st1 *SearchTree[string, i
 	}
 }
 
+func TestSearchTree_DecodingLegacyEncoding(t *testing.T) {
+	st1 := NewSearchTreeWithOptions[string, int](func(x, y int) int { return x - y }, TreeWithIntervalPoint())
+	st1.Insert(17, 19, "node1")
+	st1.Insert(5, 8, "node2")
+	st1.Insert(4, 4, "node3")
+
+	// Trees used to be encoded with the allowIntervalPoint flag as their only config.
+	var b bytes.Buffer
+	enc := gob.NewEncoder(&b)
+	for _, v := range []any{st1.typeName(), st1.config.allowIntervalPoint, st1.root} {
+		if err := enc.Encode(v); err != nil {
+			t.Fatalf("Encode: got unexpected error %v", err)
+		}
+	}
+
+	st2 := NewSearchTree[string, int](func(x, y int) int { return x - y })
+	if err := st2.GobDecode(b.Bytes()); err != nil {
+		t.Fatalf("st.GobDecode: got unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(st1.root, st2.root) {
+		t.Fatal("Roots are not equal")
+	}
+
+	if !reflect.DeepEqual(st1.config, st2.config) {
+		t.Fatal("Configs are not equal")
+	}
+}
+
 func TestSearchTree_DecodingError(t *testing.T) {
 	tests := []struct {
 		name string
@@ -750,6 +816,10 @@ func isSizeConsistent[V, T any](h *node[V, T]) bool {
 		return false
 	}
 
+	if h.Expiring != h.Interval.expiring()+expiring(h.Left)+expiring(h.Right) {
+		return false
+	}
+
 	return isSizeConsistent(h.Left) && isSizeConsistent(h.Right)
 }
 
@@ -0,0 +1,224 @@
+package interval
+
+import "iter"
+
+// IntervalSet is a generic set of values of type T, represented as disjoint ranges.
+// Ranges added to the set are merged with the ranges they overlap, and ranges removed
+// from the set split the ranges they partly overlap, so that the set never stores overlapping ranges.
+type IntervalSet[T any] struct {
+	st *SearchTree[struct{}, T]
+}
+
+// NewIntervalSet returns an initialized interval set configured with the given options.
+// The cmp parameter is used for comparing total order of the values of type T.
+// The opts parameter is an optional list of TreeOptions that customize the behavior of the set,
+// such as allowing point ranges using TreeWithIntervalPoint, setting the bounds of the ranges added to
+// and removed from the set using TreeWithBounds, and merging adjacent ranges using TreeWithAdjacentMerge.
+//
+// NewIntervalSet will panic if cmp is nil, or if opts include TreeWithValueEquality.
+func NewIntervalSet[T any](cmp CmpFunc[T], opts ...TreeOption) *IntervalSet[T] {
+	if cmp == nil {
+		panic("NewIntervalSet: comparison function cmp cannot be nil")
+	}
+
+	return &IntervalSet[T]{
+		st: NewSearchTreeWithOptions[struct{}](cmp, opts...),
+	}
+}
+
+// Add adds the range from the given start to the given end to the set,
+// merging it with the ranges it overlaps, or is adjacent to if the set is configured with TreeWithAdjacentMerge.
+//
+// Add returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (s *IntervalSet[T]) Add(start, end T) error {
+	s.st.mu.Lock()
+	defer s.st.mu.Unlock()
+
+	r, err := s.rangeInterval(start, end)
+	if err != nil {
+		return err
+	}
+
+	includesStart, includesEnd := r.includesStart(s.st.cmp), r.includesEnd(s.st.cmp)
+	for _, it := range candidates(s.st.root, start, end, s.st.cmp, nil) {
+		overlaps := it.intersects(r.Start, r.End, r.Bounds, s.st.cmp)
+		adjacent := s.st.config.mergeAdjacent && (it.adjacent(r, s.st.cmp) || r.adjacent(it, s.st.cmp))
+		if !overlaps && !adjacent {
+			continue
+		}
+
+		if it.startsBefore(r, s.st.cmp) {
+			r.Start, includesStart = it.Start, it.includesStart(s.st.cmp)
+		}
+		if it.endsAfter(r, s.st.cmp) {
+			r.End, includesEnd = it.End, it.includesEnd(s.st.cmp)
+		}
+		r.Bounds = boundsOf(includesStart, includesEnd)
+
+		s.st.root = delete(s.st.root, it, s.st.cmp)
+	}
+
+	s.st.root = upsert(s.st.root, r, s.st.cmp)
+	s.st.root.Color = black
+
+	return nil
+}
+
+// Remove removes the range from the given start to the given end from the set,
+// splitting the ranges it partly overlaps.
+//
+// Remove returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (s *IntervalSet[T]) Remove(start, end T) error {
+	s.st.mu.Lock()
+	defer s.st.mu.Unlock()
+
+	r, err := s.rangeInterval(start, end)
+	if err != nil {
+		return err
+	}
+
+	s.st.root = removeRange(s.st.root, r, s.st.cmp)
+
+	return nil
+}
+
+// Contains reports whether the given point is in any range of the set.
+func (s *IntervalSet[T]) Contains(point T) bool {
+	s.st.mu.RLock()
+	defer s.st.mu.RUnlock()
+
+	_, ok := anyStab(s.st.root, point, s.st.cmp)
+	return ok
+}
+
+// Covers reports whether every point in the range from the given start to the given end is in the set.
+// It returns false if the given end is less than or equal to the given start value.
+func (s *IntervalSet[T]) Covers(start, end T) bool {
+	s.st.mu.RLock()
+	defer s.st.mu.RUnlock()
+
+	r, err := s.rangeInterval(start, end)
+	if err != nil {
+		return false
+	}
+
+	var covered *interval[struct{}, T]
+	for _, it := range candidates(s.st.root, start, end, s.st.cmp, nil) {
+		if covered == nil {
+			if !it.intersects(r.Start, r.End, r.Bounds, s.st.cmp) {
+				continue
+			}
+			if r.startsBefore(it, s.st.cmp) {
+				return false
+			}
+		} else if !covered.adjacent(it, s.st.cmp) {
+			return false
+		}
+
+		if !r.endsAfter(it, s.st.cmp) {
+			return true
+		}
+		covered = &it
+	}
+
+	return false
+}
+
+// Len returns the number of disjoint ranges in the set.
+func (s *IntervalSet[T]) Len() int {
+	return s.st.Size()
+}
+
+// All returns an iterator over the disjoint ranges in the set, in ascending order.
+// Point ranges are always reported with Closed bounds.
+//
+// The iteration runs over a snapshot of the set taken when it starts, without holding any lock,
+// so the set can be queried and modified from within the loop body, without affecting the iteration.
+func (s *IntervalSet[T]) All() iter.Seq[Range[T]] {
+	return func(yield func(Range[T]) bool) {
+		snap := s.st.Snapshot()
+		inOrder(snap.root, 0, func(_ int, it interval[struct{}, T]) bool {
+			return yield(it.rng())
+		})
+	}
+}
+
+// rangeInterval validates and returns the interval of the range from the given start to the given end.
+func (s *IntervalSet[T]) rangeInterval(start, end T) (interval[struct{}, T], error) {
+	return newRangeInterval[struct{}](start, end, s.st.config, s.st.cmp)
+}
+
+// newRangeInterval validates and returns the interval of the range from the given start to the given end
+// with the bounds of the given config. Point ranges are always Closed.
+func newRangeInterval[V, T any](start, end T, config TreeConfig, cmp CmpFunc[T]) (interval[V, T], error) {
+	it := interval[V, T]{
+		Start:      start,
+		End:        end,
+		AllowPoint: config.allowIntervalPoint,
+		Bounds:     config.bounds,
+	}
+
+	if it.isInvalid(cmp) {
+		return it, newInvalidIntervalError(it)
+	}
+
+	// Pieces of ranges might be points regardless of the configuration.
+	it.AllowPoint = true
+	if cmp.eq(start, end) {
+		it.Bounds = Closed
+	}
+
+	return it, nil
+}
+
+// removeRange removes the points in r from the disjoint intervals in n, keeping the parts of the intervals
+// partly overlapped by r along with their values, and returns the resulting root.
+func removeRange[V, T any](n *node[V, T], r interval[V, T], cmp CmpFunc[T]) *node[V, T] {
+	for _, it := range candidates(n, r.Start, r.End, cmp, nil) {
+		if !it.intersects(r.Start, r.End, r.Bounds, cmp) {
+			continue
+		}
+
+		n = blackRoot(delete(n, it, cmp))
+
+		if it.startsBefore(r, cmp) {
+			n = blackRoot(upsert(n, it.piece(it.Start, it.includesStart(cmp), r.Start, !r.includesStart(cmp), cmp), cmp))
+		}
+		if it.endsAfter(r, cmp) {
+			n = blackRoot(upsert(n, it.piece(r.End, !r.includesEnd(cmp), it.End, it.includesEnd(cmp), cmp), cmp))
+		}
+	}
+
+	return n
+}
+
+// piece returns the non-empty piece of it from the given start to the given end, including them as given.
+func (it interval[V, T]) piece(start T, includesStart bool, end T, includesEnd bool, cmp CmpFunc[T]) interval[V, T] {
+	it.Start, it.End = start, end
+	it.Bounds = boundsOf(includesStart, includesEnd)
+	if cmp.eq(start, end) {
+		it.Bounds = Closed
+	}
+
+	return it
+}
+
+// candidates appends to dst the intervals in n, in ascending order, which start at or before the given end
+// and end at or after the given start, regardless of their bounds.
+func candidates[V, T any](n *node[V, T], start, end T, cmp CmpFunc[T], dst []interval[V, T]) []interval[V, T] {
+	if n == nil || cmp.lt(n.MaxEnd, start) {
+		return dst
+	}
+
+	dst = candidates(n.Left, start, end, cmp, dst)
+
+	if cmp.gt(n.Interval.Start, end) {
+		return dst
+	}
+
+	if cmp.gte(n.Interval.End, start) {
+		dst = append(dst, n.Interval)
+	}
+
+	return candidates(n.Right, start, end, cmp, dst)
+}
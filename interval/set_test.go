@@ -0,0 +1,245 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestIntervalSet_Add(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts []TreeOption
+		want []Range[int]
+	}{
+		{
+			name: "Closed",
+			want: []Range[int]{
+				{Start: 1, End: 7},
+				{Start: 9, End: 12},
+			},
+		},
+		{
+			name: "HalfOpen",
+			opts: []TreeOption{TreeWithBounds(HalfOpen)},
+			want: []Range[int]{
+				{Start: 1, End: 7, Bounds: HalfOpen},
+				{Start: 9, End: 10, Bounds: HalfOpen},
+				{Start: 10, End: 12, Bounds: HalfOpen},
+			},
+		},
+		{
+			name: "HalfOpenAdjacentMerge",
+			opts: []TreeOption{TreeWithBounds(HalfOpen), TreeWithAdjacentMerge()},
+			want: []Range[int]{
+				{Start: 1, End: 7, Bounds: HalfOpen},
+				{Start: 9, End: 12, Bounds: HalfOpen},
+			},
+		},
+		{
+			name: "Open",
+			opts: []TreeOption{TreeWithBounds(Open), TreeWithAdjacentMerge()},
+			want: []Range[int]{
+				{Start: 1, End: 7, Bounds: Open},
+				{Start: 9, End: 10, Bounds: Open},
+				{Start: 10, End: 12, Bounds: Open},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewIntervalSet(func(x, y int) int { return x - y }, tc.opts...)
+			defer mustBeValidTree(t, s.st.root)
+
+			s.Add(1, 3)
+			s.Add(5, 7)
+			s.Add(2, 6)
+			s.Add(9, 10)
+			s.Add(10, 12)
+
+			if got := slices.Collect(s.All()); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("s.All(): got unexpected ranges %v; want %v", got, tc.want)
+			}
+
+			if got, want := s.Len(), len(tc.want); got != want {
+				t.Errorf("s.Len(): got unexpected value %v; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_Remove(t *testing.T) {
+	s := NewIntervalSet(func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, s.st.root)
+
+	s.Add(1, 10)
+	s.Add(12, 15)
+	s.Remove(3, 5)
+	s.Remove(10, 12)
+
+	want := []Range[int]{
+		{Start: 1, End: 3, Bounds: HalfOpen},
+		{Start: 5, End: 10, Bounds: Open},
+		{Start: 12, End: 15, Bounds: LeftHalfOpen},
+	}
+
+	if got := slices.Collect(s.All()); !reflect.DeepEqual(got, want) {
+		t.Errorf("s.All(): got unexpected ranges %v; want %v", got, want)
+	}
+
+	for _, p := range []int{1, 2, 6, 13, 15} {
+		if !s.Contains(p) {
+			t.Errorf("s.Contains(%v): got false; want true", p)
+		}
+	}
+
+	for _, p := range []int{0, 3, 4, 5, 10, 11, 12, 16} {
+		if s.Contains(p) {
+			t.Errorf("s.Contains(%v): got true; want false", p)
+		}
+	}
+}
+
+func TestIntervalSet_IntervalPoint(t *testing.T) {
+	s := NewIntervalSet(func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+
+	err := s.Add(3, 3)
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("s.Add(3, 3): got error type %T; want it to be %T", err, wantErr)
+	}
+
+	s = NewIntervalSet(func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen), TreeWithIntervalPoint())
+	if err := s.Add(3, 3); err != nil {
+		t.Fatalf("s.Add(3, 3): got unexpected error %v", err)
+	}
+
+	if !s.Contains(3) {
+		t.Error("s.Contains(3): got false; want true")
+	}
+
+	s.Add(1, 3)
+	s.Add(5, 8)
+	s.Remove(5, 7)
+
+	// Adjacent ranges are only merged with TreeWithAdjacentMerge.
+	want := []Range[int]{
+		{Start: 1, End: 3, Bounds: HalfOpen},
+		{Start: 3, End: 3, Bounds: Closed},
+		{Start: 7, End: 8, Bounds: HalfOpen},
+	}
+
+	if got := slices.Collect(s.All()); !reflect.DeepEqual(got, want) {
+		t.Errorf("s.All(): got unexpected ranges %v; want %v", got, want)
+	}
+}
+
+func TestIntervalSet_All_ModifyInLoop(t *testing.T) {
+	s := NewIntervalSet(func(x, y int) int { return x - y })
+	s.Add(1, 3)
+	s.Add(5, 8)
+
+	want := slices.Collect(s.All())
+
+	var got []Range[int]
+	for r := range s.All() {
+		got = append(got, r)
+		s.Remove(r.Start, r.End)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("s.All(): got unexpected ranges %v; want %v", got, want)
+	}
+
+	if s.Len() != 0 {
+		t.Errorf("s.Len(): got %d after removing every range; want 0", s.Len())
+	}
+}
+
+func TestIntervalSet_Random(t *testing.T) {
+	cmpFunc := func(x, y float64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	// Points are modeled in halves, so that odd indexes stand for the gaps between integers.
+	const n = 40
+	contains := func(b Bounds, start, end, p int) bool {
+		if start == end {
+			return p == 2*start
+		}
+		return (p > 2*start || p == 2*start && b.includesStart()) && (p < 2*end || p == 2*end && b.includesEnd())
+	}
+
+	for _, b := range []Bounds{Closed, HalfOpen, LeftHalfOpen, Open} {
+		for _, merge := range []bool{false, true} {
+			t.Run(fmt.Sprint(b, merge), func(t *testing.T) {
+				opts := []TreeOption{TreeWithBounds(b), TreeWithIntervalPoint()}
+				if merge {
+					opts = append(opts, TreeWithAdjacentMerge())
+				}
+				s := NewIntervalSet(cmpFunc, opts...)
+
+				var model [2*(n+8) + 1]bool
+				r := rand.New(rand.NewSource(1))
+				for i := 0; i < 500; i++ {
+					start := r.Intn(n)
+					end := start + r.Intn(5)
+					if end > n {
+						end = n
+					}
+
+					add := r.Intn(3) > 0
+					if add {
+						s.Add(float64(start), float64(end))
+					} else {
+						s.Remove(float64(start), float64(end))
+					}
+					for p := range model {
+						if contains(b, start, end, p) {
+							model[p] = add
+						}
+					}
+					mustBeValidTree(t, s.st.root)
+
+					for p, want := range model {
+						if got := s.Contains(float64(p) / 2); got != want {
+							t.Fatalf("s.Contains(%v): got %v; want %v", float64(p)/2, got, want)
+						}
+					}
+
+					ranges := slices.Collect(s.All())
+					for j := 1; j < len(ranges); j++ {
+						x := interval[struct{}, float64]{Start: ranges[j-1].Start, End: ranges[j-1].End, Bounds: ranges[j-1].Bounds}
+						y := interval[struct{}, float64]{Start: ranges[j].Start, End: ranges[j].End, Bounds: ranges[j].Bounds}
+						if x.intersects(y.Start, y.End, y.Bounds, cmpFunc) || merge && x.adjacent(y, cmpFunc) {
+							t.Fatalf("s.All(): got unmerged ranges %v and %v", ranges[j-1], ranges[j])
+						}
+					}
+
+					qStart := r.Intn(n)
+					qEnd := qStart + r.Intn(8)
+					want := true
+					for p := range model {
+						if contains(b, qStart, qEnd, p) && !model[p] {
+							want = false
+						}
+					}
+					if got := s.Covers(float64(qStart), float64(qEnd)); got != want {
+						t.Fatalf("s.Covers(%v, %v): got %v; want %v in %v", qStart, qEnd, got, want, ranges)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package interval
+
+import "slices"
+
+// Union returns a new tree with the intervals of the tree and the intervals of other.
+// When both trees have an interval with the same key, the interval of other is kept
+// with the value returned by resolve for the value of the tree and the value of other, in that order.
+// If resolve is nil, the value of other is kept.
+//
+// Union runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *SearchTree[V, T]) Union(other *SearchTree[V, T], resolve func(x, y V) V) *SearchTree[V, T] {
+	return st.setOp(other, func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return union(a, ah, b, bh, resolveValue[V, T](resolve), st.cmp)
+	})
+}
+
+// Intersect returns a new tree with the intervals of the tree whose keys are also in other.
+// The interval of other is kept with the value returned by resolve for the value of the tree
+// and the value of other, in that order. If resolve is nil, the value of other is kept.
+//
+// Intersect runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *SearchTree[V, T]) Intersect(other *SearchTree[V, T], resolve func(x, y V) V) *SearchTree[V, T] {
+	return st.setOp(other, func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return intersect(a, ah, b, bh, resolveValue[V, T](resolve), st.cmp)
+	})
+}
+
+// Difference returns a new tree with the intervals of the tree whose keys are not in other.
+//
+// Difference runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *SearchTree[V, T]) Difference(other *SearchTree[V, T]) *SearchTree[V, T] {
+	return st.setOp(other, func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return difference(a, ah, b, bh, st.cmp)
+	})
+}
+
+// Union returns a new tree with the intervals of the tree and the intervals of other.
+// When both trees have an interval with the same key, the interval of other is kept
+// with the values of the tree followed by the values of other, leaving out the values already in the tree
+// if it's configured with TreeWithValueEquality.
+//
+// Union runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *MultiValueSearchTree[V, T]) Union(other *MultiValueSearchTree[V, T]) *MultiValueSearchTree[V, T] {
+	t := (*SearchTree[V, T])(st).setOp((*SearchTree[V, T])(other), func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return union(a, ah, b, bh, appendValues[V, T](valueSetEqual[V](st.config)), st.cmp)
+	})
+	return (*MultiValueSearchTree[V, T])(t)
+}
+
+// Intersect returns a new tree with the intervals of the tree whose keys are also in other.
+// The interval of other is kept with the values of the tree followed by the values of other,
+// leaving out the values already in the tree if it's configured with TreeWithValueEquality.
+//
+// Intersect runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *MultiValueSearchTree[V, T]) Intersect(other *MultiValueSearchTree[V, T]) *MultiValueSearchTree[V, T] {
+	t := (*SearchTree[V, T])(st).setOp((*SearchTree[V, T])(other), func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return intersect(a, ah, b, bh, appendValues[V, T](valueSetEqual[V](st.config)), st.cmp)
+	})
+	return (*MultiValueSearchTree[V, T])(t)
+}
+
+// Difference returns a new tree with the intervals of the tree whose keys are not in other.
+//
+// Difference runs in O(m log(n/m + 1)) time, where m and n are the sizes of the smaller and the larger tree,
+// and leaves both trees unchanged. The returned tree uses the comparison function and the options of the tree.
+func (st *MultiValueSearchTree[V, T]) Difference(other *MultiValueSearchTree[V, T]) *MultiValueSearchTree[V, T] {
+	t := (*SearchTree[V, T])(st).setOp((*SearchTree[V, T])(other), func(a, b *node[V, T], ah, bh int) (*node[V, T], int) {
+		return difference(a, ah, b, bh, st.cmp)
+	})
+	return (*MultiValueSearchTree[V, T])(t)
+}
+
+// setOp returns a new tree with the root returned by op for the snapshots of the tree and other,
+// so that neither tree is locked while op runs.
+func (st *SearchTree[V, T]) setOp(other *SearchTree[V, T], op func(a, b *node[V, T], ah, bh int) (*node[V, T], int)) *SearchTree[V, T] {
+	a, b := st.Snapshot(), other.Snapshot()
+
+	root, _ := op(a.root, b.root, blackHeight(a.root), blackHeight(b.root))
+
+	return &SearchTree[V, T]{
+		root:   blackRoot(root),
+		cmp:    a.cmp,
+		config: a.config,
+	}
+}
+
+func resolveValue[V, T any](resolve func(x, y V) V) func(x, y interval[V, T]) interval[V, T] {
+	return func(x, y interval[V, T]) interval[V, T] {
+		if resolve != nil {
+			y.Val = resolve(x.Val, y.Val)
+		}
+		return y
+	}
+}
+
+func appendValues[V, T any](eq EqualFunc[V]) func(x, y interval[V, T]) interval[V, T] {
+	return func(x, y interval[V, T]) interval[V, T] {
+		y.Vals = appendUnique(slices.Clip(x.Vals), y.Vals, eq)
+		return y
+	}
+}
+
+// union returns a tree with the intervals of a and b, along with its black height,
+// using merge to combine the intervals with the same key.
+// Every node of a and b must be shared, or be reachable from shared nodes only.
+func union[V, T any](a *node[V, T], ah int, b *node[V, T], bh int, merge func(x, y interval[V, T]) interval[V, T], cmp CmpFunc[T]) (*node[V, T], int) {
+	if a == nil {
+		return b, bh
+	}
+	if b == nil {
+		return a, ah
+	}
+
+	bl, bch, br := sharedChildren(b, bh)
+
+	l, lh, intervl, found, r, rh := split(a, ah, b.Interval, cmp)
+	if found {
+		intervl = merge(intervl, b.Interval)
+	} else {
+		intervl = b.Interval
+	}
+
+	l, lh = union(l, lh, bl, bch, merge, cmp)
+	r, rh = union(r, rh, br, bch, merge, cmp)
+
+	return join(l, lh, intervl, r, rh, cmp)
+}
+
+// intersect returns a tree with the intervals of a whose keys are also in b, along with its black height,
+// using merge to combine the intervals with the same key.
+// Every node of a and b must be shared, or be reachable from shared nodes only.
+func intersect[V, T any](a *node[V, T], ah int, b *node[V, T], bh int, merge func(x, y interval[V, T]) interval[V, T], cmp CmpFunc[T]) (*node[V, T], int) {
+	if a == nil || b == nil {
+		return nil, 0
+	}
+
+	bl, bch, br := sharedChildren(b, bh)
+
+	l, lh, intervl, found, r, rh := split(a, ah, b.Interval, cmp)
+
+	l, lh = intersect(l, lh, bl, bch, merge, cmp)
+	r, rh = intersect(r, rh, br, bch, merge, cmp)
+
+	if found {
+		return join(l, lh, merge(intervl, b.Interval), r, rh, cmp)
+	}
+
+	return join2(l, lh, r, rh, cmp)
+}
+
+// difference returns a tree with the intervals of a whose keys are not in b, along with its black height.
+// Every node of a and b must be shared, or be reachable from shared nodes only.
+func difference[V, T any](a *node[V, T], ah int, b *node[V, T], bh int, cmp CmpFunc[T]) (*node[V, T], int) {
+	if a == nil {
+		return nil, 0
+	}
+	if b == nil {
+		return a, ah
+	}
+
+	bl, bch, br := sharedChildren(b, bh)
+
+	l, lh, _, _, r, rh := split(a, ah, b.Interval, cmp)
+
+	l, lh = difference(l, lh, bl, bch, cmp)
+	r, rh = difference(r, rh, br, bch, cmp)
+
+	return join2(l, lh, r, rh, cmp)
+}
@@ -0,0 +1,166 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSearchTree_SetOperations(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+	sum := func(x, y int) int { return x + y }
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a := NewSearchTree[int](cmpFunc)
+		b := NewSearchTree[int](cmpFunc)
+
+		type key struct{ start, end int }
+		aVals, bVals := make(map[key]int), make(map[key]int)
+		for j, n := 0, r.Intn(200); j < n; j++ {
+			k := key{r.Intn(50), 0}
+			k.end = k.start + 1 + r.Intn(5)
+			a.Insert(k.start, k.end, j)
+			aVals[k] = j
+		}
+		for j, n := 0, r.Intn(200); j < n; j++ {
+			k := key{r.Intn(50), 0}
+			k.end = k.start + 1 + r.Intn(5)
+			b.Insert(k.start, k.end, 1000+j)
+			bVals[k] = 1000 + j
+		}
+
+		wantA, wantB := entriesOf(a), entriesOf(b)
+
+		var wantUnion, wantIntersect, wantDifference []Entry[int, int]
+		for k, v := range aVals {
+			if w, ok := bVals[k]; ok {
+				wantUnion = append(wantUnion, Entry[int, int]{Start: k.start, End: k.end, Val: v + w})
+				wantIntersect = append(wantIntersect, Entry[int, int]{Start: k.start, End: k.end, Val: v + w})
+				continue
+			}
+			wantUnion = append(wantUnion, Entry[int, int]{Start: k.start, End: k.end, Val: v})
+			wantDifference = append(wantDifference, Entry[int, int]{Start: k.start, End: k.end, Val: v})
+		}
+		for k, v := range bVals {
+			if _, ok := aVals[k]; !ok {
+				wantUnion = append(wantUnion, Entry[int, int]{Start: k.start, End: k.end, Val: v})
+			}
+		}
+
+		testCases := []struct {
+			name string
+			got  *SearchTree[int, int]
+			want []Entry[int, int]
+		}{
+			{name: "Union", got: a.Union(b, sum), want: wantUnion},
+			{name: "Intersect", got: a.Intersect(b, sum), want: wantIntersect},
+			{name: "Difference", got: a.Difference(b), want: wantDifference},
+		}
+
+		for _, tc := range testCases {
+			mustBeValidTree(t, tc.got.root)
+			mustHaveConsistentMaxEnd(t, tc.got.root, cmpFunc)
+
+			slices.SortFunc(tc.want, func(x, y Entry[int, int]) int {
+				if x.Start != y.Start {
+					return x.Start - y.Start
+				}
+				return x.End - y.End
+			})
+
+			if got := entriesOf(tc.got); len(got) != len(tc.want) || len(got) > 0 && !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("a.%s(b): got unexpected entries %v; want %v", tc.name, got, tc.want)
+			}
+
+			// Writes to the result must not affect the operands.
+			tc.got.DeleteMin()
+			tc.got.Insert(1, 2, -1)
+		}
+
+		if got := entriesOf(a); !reflect.DeepEqual(got, wantA) {
+			t.Fatalf("a: got tree modified to %v; want %v", got, wantA)
+		}
+		if got := entriesOf(b); !reflect.DeepEqual(got, wantB) {
+			t.Fatalf("b: got tree modified to %v; want %v", got, wantB)
+		}
+	}
+}
+
+func TestSearchTree_Union_NilResolve(t *testing.T) {
+	a := NewSearchTree[string](func(x, y int) int { return x - y })
+	a.Insert(1, 5, "node1")
+	a.Insert(2, 6, "node2")
+
+	b := NewSearchTree[string](func(x, y int) int { return x - y })
+	b.InsertWithBounds(1, 5, HalfOpen, "node3")
+
+	want := []Entry[string, int]{
+		{Start: 1, End: 5, Bounds: HalfOpen, Val: "node3"},
+		{Start: 2, End: 6, Val: "node2"},
+	}
+
+	if got := entriesOf(a.Union(b, nil)); !reflect.DeepEqual(got, want) {
+		t.Errorf("a.Union(b, nil): got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_SetOperations(t *testing.T) {
+	a := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	a.Insert(1, 5, "node1")
+	a.Insert(2, 6, "node2")
+
+	b := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	b.Insert(1, 5, "node3", "node4")
+	b.Insert(7, 9, "node5")
+
+	testCases := []struct {
+		name string
+		got  *MultiValueSearchTree[string, int]
+		want []Entry[string, int]
+	}{
+		{
+			name: "Union",
+			got:  a.Union(b),
+			want: []Entry[string, int]{
+				{Start: 1, End: 5, Vals: []string{"node1", "node3", "node4"}},
+				{Start: 2, End: 6, Vals: []string{"node2"}},
+				{Start: 7, End: 9, Vals: []string{"node5"}},
+			},
+		},
+		{
+			name: "Intersect",
+			got:  a.Intersect(b),
+			want: []Entry[string, int]{
+				{Start: 1, End: 5, Vals: []string{"node1", "node3", "node4"}},
+			},
+		},
+		{
+			name: "Difference",
+			got:  a.Difference(b),
+			want: []Entry[string, int]{
+				{Start: 2, End: 6, Vals: []string{"node2"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mustBeValidTree(t, tc.got.root)
+
+			var got []Entry[string, int]
+			for _, e := range tc.got.All() {
+				got = append(got, e)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("a.%s(b): got unexpected entries %v; want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+
+	if got, _ := a.Find(1, 5); !reflect.DeepEqual(got, []string{"node1"}) {
+		t.Errorf("a.Find(1, 5): got unexpected values %v; want [node1]", got)
+	}
+}
@@ -0,0 +1,110 @@
+package interval
+
+// SearchTreeView is an immutable, point-in-time view of a SearchTree, as returned by its Snapshot method.
+// It has the query methods of the tree, which read the view without taking any lock,
+// so they never wait for the writers of the tree, nor the other way around.
+//
+// A SearchTreeView is safe for concurrent use by multiple goroutines.
+type SearchTreeView[V, T any] struct {
+	root   *node[V, T]
+	cmp    CmpFunc[T]
+	config TreeConfig
+}
+
+// MultiValueSearchTreeView is an immutable, point-in-time view of a MultiValueSearchTree,
+// as returned by its Snapshot method. For more details, see SearchTreeView.
+type MultiValueSearchTreeView[V, T any] SearchTreeView[V, T]
+
+// Tree is implemented by SearchTree, MultiValueSearchTree and their views, so that queries
+// which are functions rather than methods, such as FirstFit, can be run on any of them.
+type Tree[V, T any] interface {
+	// read calls fn with a view of the tree which must not be used after fn returns.
+	read(fn func(*SearchTreeView[V, T]))
+}
+
+// Snapshot returns an immutable view of the current state of the tree in O(1) time.
+//
+// The tree and its snapshot share their nodes, and writes to the tree copy only the nodes
+// along the modified path instead of modifying the shared ones, so the snapshot never changes.
+// To get a copy of the tree that can be modified as well, see Clone.
+func (st *SearchTree[V, T]) Snapshot() *SearchTreeView[V, T] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.share().view()
+}
+
+// Clone returns an independent copy of the tree with the same config in O(1) time.
+//
+// The tree and its clone share their nodes until either of them is modified, in which case
+// the modified tree copies only the nodes along the modified path. So modifying the tree never affects
+// its clone and vice versa.
+func (st *SearchTree[V, T]) Clone() *SearchTree[V, T] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return &SearchTree[V, T]{
+		root:   st.share().root,
+		cmp:    st.cmp,
+		config: st.config,
+	}
+}
+
+// share marks the root of the tree as shared, so that the tree copies its nodes before modifying them.
+func (st *SearchTree[V, T]) share() *SearchTree[V, T] {
+	if st.root != nil {
+		st.root.shared.Store(true)
+	}
+	return st
+}
+
+// view returns a view of the current state of the tree, without marking its nodes as shared,
+// so the tree must be locked while the view is in use.
+func (st *SearchTree[V, T]) view() *SearchTreeView[V, T] {
+	return &SearchTreeView[V, T]{
+		root:   st.root,
+		cmp:    st.cmp,
+		config: st.config,
+	}
+}
+
+// Snapshot returns an immutable view of the current state of the tree in O(1) time.
+//
+// The tree and its snapshot share their nodes, and writes to the tree copy only the nodes
+// along the modified path instead of modifying the shared ones, so the snapshot never changes.
+// To get a copy of the tree that can be modified as well, see Clone.
+func (st *MultiValueSearchTree[V, T]) Snapshot() *MultiValueSearchTreeView[V, T] {
+	return (*MultiValueSearchTreeView[V, T])((*SearchTree[V, T])(st).Snapshot())
+}
+
+// Clone returns an independent copy of the tree with the same config in O(1) time.
+//
+// The tree and its clone share their nodes until either of them is modified, in which case
+// the modified tree copies only the nodes along the modified path. So modifying the tree never affects
+// its clone and vice versa.
+func (st *MultiValueSearchTree[V, T]) Clone() *MultiValueSearchTree[V, T] {
+	return (*MultiValueSearchTree[V, T])((*SearchTree[V, T])(st).Clone())
+}
+
+func (st *MultiValueSearchTree[V, T]) view() *MultiValueSearchTreeView[V, T] {
+	return (*MultiValueSearchTreeView[V, T])((*SearchTree[V, T])(st).view())
+}
+
+func (st *SearchTree[V, T]) read(fn func(*SearchTreeView[V, T])) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	fn(st.view())
+}
+
+func (st *SearchTreeView[V, T]) read(fn func(*SearchTreeView[V, T])) {
+	fn(st)
+}
+
+func (st *MultiValueSearchTree[V, T]) read(fn func(*SearchTreeView[V, T])) {
+	(*SearchTree[V, T])(st).read(fn)
+}
+
+func (st *MultiValueSearchTreeView[V, T]) read(fn func(*SearchTreeView[V, T])) {
+	fn((*SearchTreeView[V, T])(st))
+}
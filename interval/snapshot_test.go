@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSearchTree_Snapshot(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+	st := NewSearchTree[int](cmpFunc)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		start := r.Intn(1000)
+		st.Insert(start, start+1+r.Intn(50), i)
+	}
+
+	snapshot := st.Snapshot()
+	want := entriesOf(st)
+
+	for i := 0; i < 1000; i++ {
+		start := r.Intn(1000)
+		end := start + 1 + r.Intn(50)
+		switch r.Intn(4) {
+		case 0:
+			st.Delete(start, end)
+		case 1:
+			st.DeleteMin()
+		case 2:
+			st.DeleteMax()
+		default:
+			st.Insert(start, end, -i)
+		}
+	}
+	mustBeValidTree(t, st.root)
+	mustHaveConsistentMaxEnd(t, st.root, cmpFunc)
+
+	if got := entriesOfView(snapshot); !reflect.DeepEqual(got, want) {
+		t.Fatalf("st.Snapshot(): got snapshot modified by writes to the tree")
+	}
+	mustBeValidTree(t, snapshot.root)
+	mustHaveConsistentMaxEnd(t, snapshot.root, cmpFunc)
+
+	if got, want := snapshot.Size(), len(want); got != want {
+		t.Errorf("snapshot.Size(): got unexpected value %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_Clone(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+	st := NewSearchTree[int](cmpFunc)
+	for i := 0; i < 100; i++ {
+		st.Insert(i, i+10, i)
+	}
+
+	clone := st.Clone()
+	want := entriesOf(st)
+
+	for _, e := range want {
+		clone.Delete(e.Start, e.End)
+	}
+	clone.Insert(200, 210, 200)
+	st.Insert(300, 310, 300)
+
+	if got := entriesOf(clone); !reflect.DeepEqual(got, []Entry[int, int]{{Start: 200, End: 210, Val: 200}}) {
+		t.Errorf("st.Clone(): got unexpected entries %v in the clone", got)
+	}
+
+	want = append(want, Entry[int, int]{Start: 300, End: 310, Val: 300})
+	if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("clone.Delete: got tree modified by writes to the clone")
+	}
+
+	mustBeValidTree(t, st.root)
+	mustHaveConsistentMaxEnd(t, st.root, cmpFunc)
+	mustBeValidTree(t, clone.root)
+	mustHaveConsistentMaxEnd(t, clone.root, cmpFunc)
+}
+
+func TestSearchTree_Snapshot_Concurrent(t *testing.T) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+	for i := 0; i < 1000; i++ {
+		st.Insert(i, i+10, i)
+	}
+
+	snapshot := st.Snapshot()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			st.Delete(i, i+10)
+			st.Insert(i, i+5, -i)
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		vals, _ := snapshot.AllIntersections(0, 2000)
+		if got, want := len(vals), 1000; got != want {
+			t.Fatalf("snapshot.AllIntersections(0, 2000): got %v values; want %v", got, want)
+		}
+	}
+
+	wg.Wait()
+}
+
+func TestMultiValueSearchTree_Snapshot(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	vals := make([]string, 1, 10)
+	vals[0] = "node1"
+	st.Insert(1, 5, vals...)
+	st.Insert(3, 7, "node2")
+
+	snapshot := st.Snapshot()
+	clone := st.Clone()
+
+	st.Insert(1, 5, "node3")
+	clone.Insert(1, 5, "node4")
+	st.Insert(2, 4, "node5")
+
+	if got, _ := st.Find(1, 5); !reflect.DeepEqual(got, []string{"node1", "node3"}) {
+		t.Errorf("st.Find(1, 5): got unexpected values %v", got)
+	}
+
+	if got, _ := clone.Find(1, 5); !reflect.DeepEqual(got, []string{"node1", "node4"}) {
+		t.Errorf("clone.Find(1, 5): got unexpected values %v", got)
+	}
+
+	if got, _ := snapshot.Find(1, 5); !reflect.DeepEqual(got, []string{"node1"}) {
+		t.Errorf("snapshot.Find(1, 5): got unexpected values %v", got)
+	}
+
+	if _, ok := snapshot.Find(2, 4); ok {
+		t.Error("snapshot.Find(2, 4): got interval inserted into the tree after the snapshot")
+	}
+}
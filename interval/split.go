@@ -0,0 +1,266 @@
+package interval
+
+import (
+	"fmt"
+	"slices"
+)
+
+// OverlappingKeysError is a description of trees that cannot be joined
+// because their interval keys overlap.
+type OverlappingKeysError string
+
+// Error returns a string representation of the OverlappingKeysError error.
+func (e OverlappingKeysError) Error() string {
+	return string(e)
+}
+
+func newOverlappingKeysError[V, T any](x, y interval[V, T]) error {
+	s := fmt.Sprintf("interval search tree: cannot join trees with overlapping interval keys (%v, %v) and (%v, %v)", x.Start, x.End, y.Start, y.End)
+	return OverlappingKeysError(s)
+}
+
+// Split returns two trees with the intervals of the tree whose keys are less than the given start and end
+// interval key, and the ones whose keys are greater than or equal to it, respectively.
+// Interval keys are ordered by their start, then by their end.
+//
+// Split runs in O(log n) time and leaves the tree unchanged, since the returned trees
+// share their nodes with it the same way a Clone does.
+func (st *SearchTree[V, T]) Split(start, end T) (left, right *SearchTree[V, T]) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if st.root != nil {
+		st.root.shared.Store(true)
+	}
+
+	key := interval[V, T]{Start: start, End: end}
+	l, _, intervl, found, r, rh := split(st.root, blackHeight(st.root), key, st.cmp)
+	if found {
+		r, _ = join(nil, 0, intervl, r, rh, st.cmp)
+	}
+	l, r = blackRoot(l), blackRoot(r)
+
+	left = &SearchTree[V, T]{root: l, cmp: st.cmp, config: st.config}
+	right = &SearchTree[V, T]{root: r, cmp: st.cmp, config: st.config}
+
+	return left, right
+}
+
+// Join copies the intervals of other into the tree, leaving other unchanged.
+// Either all the interval keys of other must be less than the ones of the tree, or all of them must be greater.
+//
+// The intervals are copied the same way a Clone does, by sharing the nodes of other, so Join runs in O(log n) time.
+// It returns an OverlappingKeysError with the closest overlapping interval keys of the trees if they overlap,
+// in which case the tree is left unchanged.
+func (st *SearchTree[V, T]) Join(other *SearchTree[V, T]) error {
+	// Take a snapshot of other, so that both trees are never locked at the same time.
+	o := other.Snapshot()
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if o.root == nil {
+		return nil
+	}
+
+	if st.root == nil {
+		st.root = o.root
+		return nil
+	}
+
+	l, r := st.root, o.root
+	if !max(l).Interval.less(min(r).Interval.Start, min(r).Interval.End, st.cmp) {
+		l, r = r, l
+		if !max(l).Interval.less(min(r).Interval.Start, min(r).Interval.End, st.cmp) {
+			return newOverlappingKeysError(max(l).Interval, min(r).Interval)
+		}
+	}
+
+	st.root, _ = join2(l, blackHeight(l), r, blackHeight(r), st.cmp)
+
+	return nil
+}
+
+// Split returns two trees with the intervals of the tree whose keys are less than the given start and end
+// interval key, and the ones whose keys are greater than or equal to it, respectively.
+// Interval keys are ordered by their start, then by their end.
+//
+// Split runs in O(log n) time and leaves the tree unchanged, since the returned trees
+// share their nodes with it the same way a Clone does.
+func (st *MultiValueSearchTree[V, T]) Split(start, end T) (left, right *MultiValueSearchTree[V, T]) {
+	l, r := (*SearchTree[V, T])(st).Split(start, end)
+	return (*MultiValueSearchTree[V, T])(l), (*MultiValueSearchTree[V, T])(r)
+}
+
+// Join copies the intervals of other into the tree, leaving other unchanged.
+// Either all the interval keys of other must be less than the ones of the tree, or all of them must be greater.
+//
+// The intervals are copied the same way a Clone does, by sharing the nodes of other, so Join runs in O(log n) time.
+// It returns an OverlappingKeysError with the closest overlapping interval keys of the trees if they overlap,
+// in which case the tree is left unchanged.
+func (st *MultiValueSearchTree[V, T]) Join(other *MultiValueSearchTree[V, T]) error {
+	return (*SearchTree[V, T])(st).Join((*SearchTree[V, T])(other))
+}
+
+// blackHeight returns the number of black nodes in any path from n to a leaf.
+func blackHeight[V, T any](n *node[V, T]) int {
+	var h int
+	for ; n != nil; n = n.Left {
+		if !isRed(n) {
+			h++
+		}
+	}
+	return h
+}
+
+// split splits the subtree n of black height h into the subtrees with the keys less than the given key,
+// and the ones with the keys greater than it, returning them along with their black heights.
+// If there's an interval with the given key in n, split returns it as well.
+// Every node of n must be shared, or be reachable from shared nodes only.
+func split[V, T any](n *node[V, T], h int, key interval[V, T], cmp CmpFunc[T]) (l *node[V, T], lh int, intervl interval[V, T], found bool, r *node[V, T], rh int) {
+	if n == nil {
+		return nil, 0, intervl, false, nil, 0
+	}
+
+	nl, ch, nr := sharedChildren(n, h)
+
+	switch {
+	case n.Interval.equal(key.Start, key.End, cmp):
+		return nl, ch, n.Interval, true, nr, ch
+	case n.Interval.less(key.Start, key.End, cmp):
+		l, lh, intervl, found, r, rh = split(nr, ch, key, cmp)
+		l, lh = join(nl, ch, n.Interval, l, lh, cmp)
+	default:
+		l, lh, intervl, found, r, rh = split(nl, ch, key, cmp)
+		r, rh = join(r, rh, n.Interval, nr, ch, cmp)
+	}
+
+	return l, lh, intervl, found, r, rh
+}
+
+// sharedChildren marks the children of n as shared and returns them along with their black height,
+// given the black height h of n.
+func sharedChildren[V, T any](n *node[V, T], h int) (l *node[V, T], ch int, r *node[V, T]) {
+	if n.Left != nil {
+		n.Left.shared.Store(true)
+	}
+	if n.Right != nil {
+		n.Right.shared.Store(true)
+	}
+
+	ch = h
+	if !isRed(n) {
+		ch--
+	}
+
+	return n.Left, ch, n.Right
+}
+
+// join returns a tree with the intervals of l, the given intervl and the intervals of r, along with its black height,
+// where l has black height lh and keys less than the key of intervl, and r has black height rh and keys greater than it.
+func join[V, T any](l *node[V, T], lh int, intervl interval[V, T], r *node[V, T], rh int, cmp CmpFunc[T]) (*node[V, T], int) {
+	if isRed(l) {
+		l = mutable(l)
+		l.Color = black
+		lh++
+	}
+	if isRed(r) {
+		r = mutable(r)
+		r.Color = black
+		rh++
+	}
+
+	// The interval might be shared with other trees, so its values must not be appended to in place.
+	intervl.Vals = slices.Clip(intervl.Vals)
+	m := newNode(intervl, red)
+
+	var n *node[V, T]
+	switch {
+	case lh == rh:
+		m.Left, m.Right = l, r
+		m.Color = black
+		updateSize(m)
+		updateMaxEnd(m, cmp)
+		return m, lh + 1
+	case lh > rh:
+		n = joinRight(l, lh, m, r, rh, cmp)
+	default:
+		n = joinLeft(r, rh, m, l, lh, cmp)
+	}
+
+	h := lh
+	if rh > h {
+		h = rh
+	}
+	if isRed(n) {
+		n.Color = black
+		h++
+	}
+
+	return n, h
+}
+
+// joinRight inserts the red node m, with r as its right child, in the right spine of n,
+// where n has black height h greater than or equal to the black height rh of r.
+func joinRight[V, T any](n *node[V, T], h int, m, r *node[V, T], rh int, cmp CmpFunc[T]) *node[V, T] {
+	if h == rh {
+		m.Left, m.Right = n, r
+		updateSize(m)
+		updateMaxEnd(m, cmp)
+		return m
+	}
+
+	// There are no red right links, so every node in the right spine of n is black.
+	n = mutable(n)
+	n.Right = joinRight(n.Right, h-1, m, r, rh, cmp)
+	updateSize(n)
+
+	return fixUp(n, cmp)
+}
+
+// joinLeft inserts the red node m, with l as its left child, in the left spine of n,
+// where n has black height h greater than or equal to the black height lh of l.
+func joinLeft[V, T any](n *node[V, T], h int, m, l *node[V, T], lh int, cmp CmpFunc[T]) *node[V, T] {
+	if h == lh && !isRed(n) {
+		m.Left, m.Right = l, n
+		updateSize(m)
+		updateMaxEnd(m, cmp)
+		return m
+	}
+
+	ch := h
+	if !isRed(n) {
+		ch--
+	}
+
+	n = mutable(n)
+	n.Left = joinLeft(n.Left, ch, m, l, lh, cmp)
+	updateSize(n)
+
+	return fixUp(n, cmp)
+}
+
+// blackRoot returns n with its color set to black.
+func blackRoot[V, T any](n *node[V, T]) *node[V, T] {
+	if isRed(n) {
+		n = mutable(n)
+		n.Color = black
+	}
+	return n
+}
+
+// join2 returns a tree with the intervals of l and r, along with its black height,
+// where l has black height lh and keys less than the keys of r, which has black height rh.
+func join2[V, T any](l *node[V, T], lh int, r *node[V, T], rh int, cmp CmpFunc[T]) (*node[V, T], int) {
+	if r == nil {
+		return l, lh
+	}
+
+	intervl := min(r).Interval
+	r = deleteMin(r, cmp)
+	if r != nil {
+		r.Color = black
+	}
+
+	return join(l, lh, intervl, r, blackHeight(r), cmp)
+}
@@ -0,0 +1,168 @@
+package interval
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_Split(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 10, 57, 300} {
+		st := NewSearchTree[int](cmpFunc)
+		for i := 0; i < n; i++ {
+			start := r.Intn(100)
+			st.Insert(start, start+1+r.Intn(20), i)
+		}
+
+		want := entriesOf(st)
+
+		for i := 0; i < 50; i++ {
+			start := r.Intn(110) - 5
+			end := start + r.Intn(20)
+
+			left, right := st.Split(start, end)
+			for _, tree := range []*SearchTree[int, int]{left, right} {
+				mustBeValidTree(t, tree.root)
+				mustHaveConsistentMaxEnd(t, tree.root, cmpFunc)
+			}
+
+			var k int
+			for k < len(want) && (want[k].Start < start || want[k].Start == start && want[k].End < end) {
+				k++
+			}
+
+			if got := entriesOf(left); len(got) != k || k > 0 && !reflect.DeepEqual(got, want[:k]) {
+				t.Fatalf("st.Split(%v, %v): got unexpected left entries %v; want %v", start, end, got, want[:k])
+			}
+			if got := entriesOf(right); len(got) != len(want)-k || k < len(want) && !reflect.DeepEqual(got, want[k:]) {
+				t.Fatalf("st.Split(%v, %v): got unexpected right entries %v; want %v", start, end, got, want[k:])
+			}
+
+			left.Insert(-10, -5, -1)
+			right.DeleteMax()
+		}
+
+		if got := entriesOf(st); !reflect.DeepEqual(got, want) {
+			t.Fatalf("st.Split: got tree modified by writes to the split trees")
+		}
+	}
+}
+
+func TestSearchTree_Join(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		st := NewSearchTree[int](cmpFunc)
+		for j, n := 0, r.Intn(300); j < n; j++ {
+			start := r.Intn(1000)
+			st.Insert(start, start+1+r.Intn(20), j)
+		}
+
+		want := entriesOf(st)
+		start := r.Intn(1000)
+		left, right := st.Split(start, start+1)
+
+		x, y := left, right
+		if i%2 == 1 {
+			x, y = right, left
+		}
+		wantOther := entriesOf(y)
+
+		if err := x.Join(y); err != nil {
+			t.Fatalf("x.Join(y): got unexpected error %v", err)
+		}
+		mustBeValidTree(t, x.root)
+		mustHaveConsistentMaxEnd(t, x.root, cmpFunc)
+
+		if got := entriesOf(x); !reflect.DeepEqual(got, want) {
+			t.Fatalf("x.Join(y): got unexpected entries %v; want %v", got, want)
+		}
+
+		if got := entriesOf(y); !reflect.DeepEqual(got, wantOther) {
+			t.Fatalf("x.Join(y): got y modified to %v; want %v", got, wantOther)
+		}
+	}
+}
+
+func TestSearchTree_Join_Error(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	st.Insert(1, 5, "node1")
+	st.Insert(8, 10, "node2")
+
+	other := NewSearchTree[string](func(x, y int) int { return x - y })
+	other.Insert(4, 6, "node3")
+
+	testCases := []struct {
+		name  string
+		other *SearchTree[string, int]
+		want  string
+	}{
+		{
+			name:  "Overlapping",
+			other: other,
+			want:  "interval search tree: cannot join trees with overlapping interval keys (4, 6) and (1, 5)",
+		},
+		{
+			name:  "Self",
+			other: st,
+			want:  "interval search tree: cannot join trees with overlapping interval keys (8, 10) and (1, 5)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := st.Join(tc.other)
+
+			var wantErr OverlappingKeysError
+			if !errors.As(err, &wantErr) {
+				t.Errorf("st.Join: got error type %T; want it to be %T", err, wantErr)
+			}
+
+			if err != nil && err.Error() != tc.want {
+				t.Errorf("st.Join: got unexpected error message %q; want %q", err, tc.want)
+			}
+
+			if got, want := st.Size(), 2; got != want {
+				t.Errorf("st.Size(): got unexpected value %v after error; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMultiValueSearchTree_Split_Join(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1", "node2")
+	st.Insert(3, 7, "node3")
+	st.Insert(6, 9, "node4")
+
+	left, right := st.Split(3, 7)
+
+	if got, want := left.Size(), 1; got != want {
+		t.Errorf("left.Size(): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, _ := right.MinEntry(); got.Start != 3 || got.End != 7 {
+		t.Errorf("right.MinEntry(): got unexpected entry %v; want (3, 7)", got)
+	}
+
+	left.Insert(1, 5, "node5")
+	if err := right.Join(left); err != nil {
+		t.Fatalf("right.Join(left): got unexpected error %v", err)
+	}
+	mustBeValidTree(t, right.root)
+
+	if got, want := right.CountIntersectionValues(0, 10), 5; got != want {
+		t.Errorf("right.CountIntersectionValues(0, 10): got unexpected value %v; want %v", got, want)
+	}
+
+	if got, want := st.CountIntersectionValues(0, 10), 4; got != want {
+		t.Errorf("st.CountIntersectionValues(0, 10): got unexpected value %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,170 @@
+package interval
+
+// Stab returns a slice of values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+//
+// Unlike AllIntersections, Stab can be used on trees that don't allow point intervals.
+func (st *SearchTree[V, T]) Stab(point T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Stab(point)
+}
+
+// Stab returns a slice of values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+//
+// Unlike AllIntersections, Stab can be used on trees that don't allow point intervals.
+func (st *SearchTreeView[V, T]) Stab(point T) ([]V, bool) {
+	var vals []V
+	live := st.live()
+	stab(st.root, point, st.cmp, func(it interval[V, T]) bool {
+		if live(it) {
+			vals = append(vals, it.Val)
+		}
+		return true
+	})
+
+	return vals, len(vals) > 0
+}
+
+// AnyStab returns a value which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+func (st *SearchTree[V, T]) AnyStab(point T) (V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AnyStab(point)
+}
+
+// AnyStab returns a value which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+func (st *SearchTreeView[V, T]) AnyStab(point T) (V, bool) {
+	var val V
+	var found bool
+
+	live := st.live()
+	stab(st.root, point, st.cmp, func(it interval[V, T]) bool {
+		if live(it) {
+			val, found = it.Val, true
+		}
+		return !found
+	})
+
+	return val, found
+}
+
+// CountStab returns the number of intervals in the tree that contain the given point.
+func (st *SearchTree[V, T]) CountStab(point T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CountStab(point)
+}
+
+// CountStab returns the number of intervals in the tree that contain the given point.
+func (st *SearchTreeView[V, T]) CountStab(point T) int {
+	return st.countStab(point)
+}
+
+// countStab returns the number of intervals in the tree that contain the given point and haven't expired.
+func (st *SearchTreeView[V, T]) countStab(point T) int {
+	var count int
+	live := st.live()
+	stab(st.root, point, st.cmp, func(it interval[V, T]) bool {
+		if live(it) {
+			count++
+		}
+		return true
+	})
+
+	return count
+}
+
+// Stab returns a slice of values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+//
+// Unlike AllIntersections, Stab can be used on trees that don't allow point intervals.
+func (st *MultiValueSearchTree[V, T]) Stab(point T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().Stab(point)
+}
+
+// Stab returns a slice of values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+//
+// Unlike AllIntersections, Stab can be used on trees that don't allow point intervals.
+func (st *MultiValueSearchTreeView[V, T]) Stab(point T) ([]V, bool) {
+	var vals []V
+	stab(st.root, point, st.cmp, func(it interval[V, T]) bool {
+		vals = append(vals, it.Vals...)
+		return true
+	})
+
+	return vals, len(vals) > 0
+}
+
+// AnyStab returns values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) AnyStab(point T) ([]V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().AnyStab(point)
+}
+
+// AnyStab returns values which interval key contains the given point.
+// It returns true as the second return value if any interval contains the point; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) AnyStab(point T) ([]V, bool) {
+	interval, ok := anyStab(st.root, point, st.cmp)
+	if !ok {
+		return nil, false
+	}
+
+	return interval.Vals, true
+}
+
+// CountStab returns the number of intervals in the tree that contain the given point,
+// regardless of how many values are stored for each of them.
+func (st *MultiValueSearchTree[V, T]) CountStab(point T) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().CountStab(point)
+}
+
+// CountStab returns the number of intervals in the tree that contain the given point,
+// regardless of how many values are stored for each of them.
+func (st *MultiValueSearchTreeView[V, T]) CountStab(point T) int {
+	return countStab(st.root, point, st.cmp)
+}
+
+// stab calls foundFn in order with every interval in the tree that contains the given point,
+// until foundFn returns false.
+func stab[V, T any](root *node[V, T], point T, cmp CmpFunc[T], foundFn func(interval[V, T]) bool) {
+	if root == nil {
+		return
+	}
+
+	// A point is always included in itself regardless of bounds,
+	// so the MaxEnd pruning of searchInOrder still applies.
+	searchInOrder(root, 0, point, point, Closed, cmp, func(_ int, it interval[V, T]) bool {
+		return foundFn(it)
+	})
+}
+
+func anyStab[V, T any](root *node[V, T], point T, cmp CmpFunc[T]) (interval[V, T], bool) {
+	return anyIntersections(root, point, point, Closed, cmp)
+}
+
+func countStab[V, T any](root *node[V, T], point T, cmp CmpFunc[T]) int {
+	var count int
+	stab(root, point, cmp, func(interval[V, T]) bool {
+		count++
+		return true
+	})
+
+	return count
+}
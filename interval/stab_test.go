@@ -0,0 +1,115 @@
+package interval
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_Stab(t *testing.T) {
+	st := NewSearchTree[string](func(x, y int) int { return x - y })
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(17, 19, "node1")
+	st.Insert(5, 8, "node2")
+	st.Insert(21, 24, "node3")
+	st.Insert(4, 8, "node4")
+	st.Insert(15, 18, "node5")
+	st.Insert(7, 10, "node6")
+	st.InsertWithBounds(10, 15, Open, "node7")
+
+	testCases := []struct {
+		point    int
+		wantVals []string
+	}{
+		{point: 8, wantVals: []string{"node4", "node2", "node6"}},
+		{point: 17, wantVals: []string{"node5", "node1"}},
+		{point: 10, wantVals: []string{"node6"}},
+		{point: 12, wantVals: []string{"node7"}},
+		{point: 20},
+		{point: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.point), func(t *testing.T) {
+			got, ok := st.Stab(tc.point)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.Stab(%v): got ok value %t; want %t", tc.point, ok, want)
+			}
+
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.Stab(%v): got unexpected value %v; want %v", tc.point, got, tc.wantVals)
+			}
+
+			if got, want := st.CountStab(tc.point), len(tc.wantVals); got != want {
+				t.Errorf("st.CountStab(%v): got unexpected value %v; want %v", tc.point, got, want)
+			}
+
+			val, ok := st.AnyStab(tc.point)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.AnyStab(%v): got ok value %t; want %t", tc.point, ok, want)
+			}
+
+			if ok && val != tc.wantVals[0] {
+				t.Errorf("st.AnyStab(%v): got unexpected value %v; want %v", tc.point, val, tc.wantVals[0])
+			}
+		})
+	}
+}
+
+func TestSearchTree_Stab_EmptyTree(t *testing.T) {
+	st := NewSearchTree[any](func(x, y int) int { return x - y })
+
+	if got, ok := st.Stab(1); ok {
+		t.Errorf("st.Stab(1): got unexpected value %v", got)
+	}
+
+	if got, ok := st.AnyStab(1); ok {
+		t.Errorf("st.AnyStab(1): got unexpected value %v", got)
+	}
+
+	if got := st.CountStab(1); got != 0 {
+		t.Errorf("st.CountStab(1): got unexpected value %v", got)
+	}
+}
+
+func TestMultiValueSearchTree_Stab(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1", "node2")
+	st.Insert(5, 9, "node3")
+	st.Insert(3, 6, "node4")
+
+	testCases := []struct {
+		point     int
+		wantVals  []string
+		wantCount int
+	}{
+		{point: 5, wantVals: []string{"node4", "node3"}, wantCount: 2},
+		{point: 4, wantVals: []string{"node1", "node2", "node4"}, wantCount: 2},
+		{point: 9},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.point), func(t *testing.T) {
+			got, ok := st.Stab(tc.point)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.Stab(%v): got ok value %t; want %t", tc.point, ok, want)
+			}
+
+			if !reflect.DeepEqual(got, tc.wantVals) {
+				t.Errorf("st.Stab(%v): got unexpected value %v; want %v", tc.point, got, tc.wantVals)
+			}
+
+			if got := st.CountStab(tc.point); got != tc.wantCount {
+				t.Errorf("st.CountStab(%v): got unexpected value %v; want %v", tc.point, got, tc.wantCount)
+			}
+
+			_, ok = st.AnyStab(tc.point)
+			if want := len(tc.wantVals) > 0; ok != want {
+				t.Errorf("st.AnyStab(%v): got ok value %t; want %t", tc.point, ok, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package interval
+
+import (
+	"bufio"
+	"bytes"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// WriteTo writes the tree to w in the binary format of MarshalBinary (compatible with [io.WriterTo]),
+// streaming one entry at a time in ascending interval key order, so that the whole encoding
+// is never held in memory. It returns the number of bytes written.
+//
+// WriteTo writes a snapshot of the tree, so the tree can be modified while it's being written.
+func (st *SearchTree[V, T]) WriteTo(w io.Writer) (int64, error) {
+	snap := st.Snapshot()
+	return writeBinary(w, binaryKindSearchTree, snap.root, snap.config)
+}
+
+// ReadFrom reads the tree from r in the binary format of MarshalBinary (compatible with [io.ReaderFrom]),
+// replacing the content and the config flags of the tree, and returns the number of bytes read.
+// The tree is rebuilt in linear time as entries are decoded, so that the whole encoding is never held in memory,
+// except for the representation encoded by GobEncode, which is also accepted for migration purposes.
+//
+// ReadFrom buffers its reads from r, so it may read past the end of the tree, unless r is a *bufio.Reader.
+// It returns the same errors as UnmarshalBinary, in which case the tree is left unchanged. The representation
+// encoded by GobEncode is read up to the end of r, and rejected with an InvalidEncodingError past 256 MiB;
+// use UnmarshalBinary to decode larger ones.
+func (st *SearchTree[V, T]) ReadFrom(r io.Reader) (int64, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return readBinary(r, binaryKindSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+}
+
+// WriteTo writes the tree to w in the binary format of MarshalBinary (compatible with [io.WriterTo]),
+// streaming one entry at a time in ascending interval key order, so that the whole encoding
+// is never held in memory. It returns the number of bytes written.
+//
+// WriteTo writes a snapshot of the tree, so the tree can be modified while it's being written.
+func (st *MultiValueSearchTree[V, T]) WriteTo(w io.Writer) (int64, error) {
+	snap := st.Snapshot()
+	return writeBinary(w, binaryKindMultiValueSearchTree, snap.root, snap.config)
+}
+
+// ReadFrom reads the tree from r in the binary format of MarshalBinary (compatible with [io.ReaderFrom]),
+// replacing the content and the config flags of the tree, and returns the number of bytes read.
+// The tree is rebuilt in linear time as entries are decoded, so that the whole encoding is never held in memory,
+// except for the representation encoded by GobEncode, which is also accepted for migration purposes.
+//
+// ReadFrom buffers its reads from r, so it may read past the end of the tree, unless r is a *bufio.Reader.
+// It returns the same errors as UnmarshalBinary, in which case the tree is left unchanged. The representation
+// encoded by GobEncode is read up to the end of r, and rejected with an InvalidEncodingError past 256 MiB;
+// use UnmarshalBinary to decode larger ones.
+func (st *MultiValueSearchTree[V, T]) ReadFrom(r io.Reader) (int64, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return readBinary(r, binaryKindMultiValueSearchTree, st.typeName(), &st.root, &st.config, st.cmp)
+}
+
+func writeBinary[V, T any](w io.Writer, kind byte, root *node[V, T], config TreeConfig) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	if err := encodeBinary(bw, kind, root, config); err != nil {
+		return cw.n, err
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// maxLegacySize is the maximum size of the representation encoded by GobEncode that ReadFrom reads,
+// since it can only be decoded once it's read as a whole.
+const maxLegacySize = 256 << 20
+
+func readBinary[V, T any](r io.Reader, wantKind byte, wantTypeName string, root **node[V, T], config *TreeConfig, cmp CmpFunc[T]) (int64, error) {
+	br := bufio.NewReader(r)
+	cr := newChecksumReader(br)
+
+	if magic, err := br.Peek(len(binaryMagic)); err != nil || !bytes.Equal(magic, binaryMagic[:]) {
+		data, err := io.ReadAll(io.LimitReader(cr, maxLegacySize+1))
+		if err != nil {
+			return cr.n, err
+		}
+		if len(data) > maxLegacySize {
+			return cr.n, newInvalidEncodingError("gob representation larger than %d bytes", maxLegacySize)
+		}
+		return cr.n, unmarshalLegacy(data, wantKind, wantTypeName, root, config, cmp)
+	}
+
+	n, cfg, err := decodeBinary[V, T](cr, wantKind, wantTypeName, *config, cmp)
+	if err != nil {
+		return cr.n, err
+	}
+
+	*root, *config = n, cfg
+
+	return cr.n, nil
+}
+
+// checksumReader computes the checksum of the bytes read from r and counts them.
+// It implements io.ByteReader, so that a gob.Decoder reading from it doesn't read past the gob stream.
+type checksumReader struct {
+	r   byteReader
+	crc hash.Hash32
+	n   int64
+	b   [1]byte
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+func newChecksumReader(r byteReader) *checksumReader {
+	return &checksumReader{r: r, crc: crc32.NewIEEE()}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.crc.Write(p[:n])
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *checksumReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err != nil {
+		return b, err
+	}
+
+	r.b[0] = b
+	r.crc.Write(r.b[:])
+	r.n++
+	return b, nil
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
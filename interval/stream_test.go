@@ -0,0 +1,191 @@
+package interval
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSearchTree_WriteTo_ReadFrom(t *testing.T) {
+	st1 := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithBounds(HalfOpen))
+	for i := 0; i < 1000; i++ {
+		st1.Insert(i, i+i%7+1, "node")
+	}
+	st1.InsertWithBounds(3, 50, Closed, "node1")
+
+	var b bytes.Buffer
+	n, err := st1.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("st.WriteTo: got unexpected error %v", err)
+	}
+
+	if got, want := n, int64(b.Len()); got != want {
+		t.Errorf("st.WriteTo: got %d bytes written; want %d", got, want)
+	}
+
+	data, err := st1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	if !bytes.Equal(b.Bytes(), data) {
+		t.Error("st.WriteTo: got bytes different from st.MarshalBinary")
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	n, err = st2.ReadFrom(&b)
+	if err != nil {
+		t.Fatalf("st.ReadFrom: got unexpected error %v", err)
+	}
+
+	if got, want := n, int64(len(data)); got != want {
+		t.Errorf("st.ReadFrom: got %d bytes read; want %d", got, want)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+	}
+
+	if st2.config.bounds != HalfOpen {
+		t.Errorf("st.ReadFrom: got unexpected bounds %v; want %v", st2.config.bounds, HalfOpen)
+	}
+}
+
+func TestSearchTree_ReadFrom_Consecutive(t *testing.T) {
+	st1 := NewSearchTree[string](func(x, y int) int { return x - y })
+	st1.Insert(1, 5, "node1")
+
+	mv1 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	mv1.Insert(5, 9, "node2", "node3")
+
+	var b bytes.Buffer
+	st1.WriteTo(&b)
+	mv1.WriteTo(&b)
+
+	// A *bufio.Reader is read only up to the end of each tree.
+	r := bufio.NewReader(&b)
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	if _, err := st2.ReadFrom(r); err != nil {
+		t.Fatalf("st.ReadFrom: got unexpected error %v", err)
+	}
+
+	mv2 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	if _, err := mv2.ReadFrom(r); err != nil {
+		t.Fatalf("st.ReadFrom: got unexpected error %v", err)
+	}
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+	}
+
+	if got, want := entriesOf((*SearchTree[string, int])(mv2)), entriesOf((*SearchTree[string, int])(mv1)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_ReadFrom_Legacy(t *testing.T) {
+	st1 := NewSearchTree[string](func(x, y int) int { return x - y })
+	st1.Insert(17, 19, "node1")
+	st1.Insert(5, 8, "node2")
+
+	data, err := st1.GobEncode()
+	if err != nil {
+		t.Fatalf("st.GobEncode: got unexpected error %v", err)
+	}
+
+	st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+	if _, err := st2.ReadFrom(bytes.NewReader(data)); err != nil {
+		t.Fatalf("st.ReadFrom: got unexpected error %v", err)
+	}
+
+	if got, want := entriesOf(st2), entriesOf(st1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestSearchTree_ReadFrom_Error(t *testing.T) {
+	st1 := NewSearchTree[string](func(x, y int) int { return x - y })
+	for i := 0; i < 100; i++ {
+		st1.Insert(i, i+10, "node")
+	}
+
+	data, err := st1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("st.MarshalBinary: got unexpected error %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "Truncated", data: data[:len(data)-10]},
+		{name: "Corrupted", data: func() []byte {
+			b := bytes.Clone(data)
+			b[len(b)-binaryCRCSize-1] ^= 0xff
+			return b
+		}()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st2 := NewSearchTree[string](func(x, y int) int { return x - y })
+			st2.Insert(2, 3, "node1")
+
+			_, err := st2.ReadFrom(bytes.NewReader(tc.data))
+
+			var wantErr InvalidEncodingError
+			if !errors.As(err, &wantErr) {
+				t.Fatalf("st.ReadFrom: got error %v of type %T; want it to be %T", err, err, wantErr)
+			}
+
+			if got, want := entriesOf(st2), []Entry[string, int]{{Start: 2, End: 3, Val: "node1"}}; !reflect.DeepEqual(got, want) {
+				t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMultiValueSearchTree_WriteTo_ReadFrom(t *testing.T) {
+	st1 := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithIntervalPoint())
+	for i := 0; i < 100; i++ {
+		st1.Insert(i, i+i%3, "node1", "node2")
+	}
+
+	var b bytes.Buffer
+	if _, err := st1.WriteTo(&b); err != nil {
+		t.Fatalf("st.WriteTo: got unexpected error %v", err)
+	}
+
+	st2 := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+	if _, err := st2.ReadFrom(&b); err != nil {
+		t.Fatalf("st.ReadFrom: got unexpected error %v", err)
+	}
+
+	mustBeValidTree(t, st2.root)
+
+	if got, want := entriesOf((*SearchTree[string, int])(st2)), entriesOf((*SearchTree[string, int])(st1)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.ReadFrom: got unexpected entries %v; want %v", got, want)
+	}
+
+	if !st2.config.allowIntervalPoint {
+		t.Error("st.ReadFrom: got interval points not allowed; want allowed")
+	}
+}
+
+func BenchmarkSearchTree_WriteTo(b *testing.B) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+	for i := 0; i < 100_000; i++ {
+		st.Insert(i, i+100, i)
+	}
+
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		st.WriteTo(&buf)
+	}
+}
@@ -0,0 +1,184 @@
+package interval
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// InsertWithTTL inserts the given val with the given start and end as the interval key,
+// so that it expires once the given ttl has elapsed, as measured by the clock of the tree.
+// If there's already an interval key entry with the given start and end interval,
+// it will be updated with the given val and expiry time. Likewise, inserting the interval key
+// again with Insert makes it never expire.
+//
+// Expired entries are invisible to every query that searches the tree by interval or point, even before
+// they are removed with PurgeExpired: Find, AnyIntersection, AllIntersections, Stab, AnyStab, CountStab,
+// CountIntersections, ContainedIn, Containing, Related, Gaps, Coverage, FirstFit, BestFit, MaxDepth, DepthAt, Nearest,
+// their variants and the Intersections iterator. Each of these queries reads the clock of the tree at most once.
+//
+// However, the queries that rely on the order or the number of the entries, such as Size, Min, Max, MaxEnd,
+// Ceil, Floor, Rank, Select, and the All, Backward and From iterators, as well as the set operations,
+// still see the expired entries until they are purged. To keep them consistent, call PurgeExpired,
+// or start a janitor with StartJanitor.
+//
+// InsertWithTTL returns an InvalidIntervalError if the given end is less than or equal to the given start value.
+func (st *SearchTree[V, T]) InsertWithTTL(start, end T, val V, ttl time.Duration) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	intervl := interval[V, T]{
+		Start:      start,
+		End:        end,
+		Val:        val,
+		AllowPoint: st.config.allowIntervalPoint,
+		Bounds:     st.config.bounds,
+		ExpiresAt:  st.config.now().Add(ttl),
+	}
+
+	if intervl.isInvalid(st.cmp) {
+		return newInvalidIntervalError(intervl)
+	}
+
+	st.root = upsert(st.root, intervl, st.cmp)
+	st.root.Color = black
+
+	return nil
+}
+
+// PurgeExpired removes every entry that has expired at the given now from the tree,
+// and returns the number of removed entries.
+//
+// Only the subtrees with entries that have an expiry time are searched, and the tree is rebuilt
+// in linear time instead of removing the expired entries one by one when there are many of them.
+func (st *SearchTree[V, T]) PurgeExpired(now time.Time) int {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	expired := expiredIntervals(st.root, now, nil)
+	if len(expired) == 0 {
+		return 0
+	}
+
+	// Removing k entries one by one takes O(k log n) time, while rebuilding the tree takes O(n).
+	if n := size(st.root); len(expired)*bits.Len(uint(n)) >= n {
+		live := make([]interval[V, T], 0, n-len(expired))
+		inOrder(st.root, 0, func(_ int, it interval[V, T]) bool {
+			if !it.expired(now) {
+				live = append(live, it)
+			}
+			return true
+		})
+
+		st.root = buildFromSorted(len(live), slicePuller(live), st.cmp)
+		return len(expired)
+	}
+
+	for _, it := range expired {
+		st.root = delete(st.root, it, st.cmp)
+		if st.root != nil {
+			st.root.Color = black
+		}
+	}
+
+	return len(expired)
+}
+
+// expiredIntervals appends to dst the intervals in n that have expired at the given now, in ascending order,
+// skipping the subtrees without intervals with an expiry time.
+func expiredIntervals[V, T any](n *node[V, T], now time.Time, dst []interval[V, T]) []interval[V, T] {
+	if n == nil || n.Expiring == 0 {
+		return dst
+	}
+
+	dst = expiredIntervals(n.Left, now, dst)
+	if n.Interval.expired(now) {
+		dst = append(dst, n.Interval)
+	}
+
+	return expiredIntervals(n.Right, now, dst)
+}
+
+// StartJanitor starts a goroutine that calls PurgeExpired with the time of the clock of the tree
+// every time a value is received from the given tick channel, such as the C channel of a time.Ticker.
+// It returns a function that stops the goroutine and waits for it to return; calling it more than once does nothing.
+func (st *SearchTree[V, T]) StartJanitor(tick <-chan time.Time) (stop func()) {
+	done, stopped := make(chan struct{}), make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-tick:
+				st.PurgeExpired(st.config.now())
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+		})
+	}
+}
+
+// now returns the current time according to the clock configured with TreeWithClock, if any.
+func (c TreeConfig) now() time.Time {
+	if c.clock != nil {
+		return c.clock()
+	}
+	return time.Now()
+}
+
+// live returns a function that reports whether an interval hasn't expired according to the clock of the tree.
+// The clock is read at most once, the first time an interval with an expiry time is checked,
+// so that every interval visited by a query is checked against the same time.
+func (st *SearchTreeView[V, T]) live() func(interval[V, T]) bool {
+	var now time.Time
+	var read bool
+	return func(it interval[V, T]) bool {
+		if it.ExpiresAt.IsZero() {
+			return true
+		}
+
+		if !read {
+			now, read = st.config.now(), true
+		}
+		return !it.expired(now)
+	}
+}
+
+// unexpired wraps foundFn so that it's only called with the intervals that haven't expired.
+func (st *SearchTreeView[V, T]) unexpired(foundFn func(int, interval[V, T]) bool) func(int, interval[V, T]) bool {
+	live := st.live()
+	return func(rank int, it interval[V, T]) bool {
+		if !live(it) {
+			return true
+		}
+		return foundFn(rank, it)
+	}
+}
+
+// countExpired returns the number of intervals in n that have expired according to live and intersect with q,
+// skipping the subtrees without intervals with an expiry time.
+func countExpired[V, T any](n *node[V, T], q interval[V, T], live func(interval[V, T]) bool, cmp CmpFunc[T]) int {
+	if n == nil || n.Expiring == 0 || cmp.lt(n.MaxEnd, q.Start) {
+		return 0
+	}
+
+	count := countExpired(n.Left, q, live, cmp)
+	if cmp.gt(n.Interval.Start, q.End) {
+		return count
+	}
+
+	if n.Interval.intersects(q.Start, q.End, q.Bounds, cmp) && !live(n.Interval) {
+		count++
+	}
+
+	return count + countExpired(n.Right, q, live, cmp)
+}
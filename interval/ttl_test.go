@@ -0,0 +1,305 @@
+package interval
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock that only moves forward when told so.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func TestSearchTree_InsertWithTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithClock(clock.Now))
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 5, "node1")
+	st.InsertWithTTL(3, 7, "node2", time.Minute)
+	st.InsertWithTTL(6, 9, "node3", time.Hour)
+
+	want := Entry[string, int]{Start: 3, End: 7, Val: "node2", ExpiresAt: clock.Now().Add(time.Minute)}
+	if got, _ := st.SelectEntry(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.SelectEntry(1): got unexpected entry %v; want %v", got, want)
+	}
+
+	if got, ok := st.Find(3, 7); !ok || got != "node2" {
+		t.Errorf("st.Find(3, 7): got unexpected value %v, %v; want node2, true", got, ok)
+	}
+
+	clock.Advance(time.Minute)
+
+	if got, ok := st.Find(3, 7); ok {
+		t.Errorf("st.Find(3, 7): got unexpected expired value %v", got)
+	}
+
+	if got, want := allIntersectionsOf(st, 4, 6), []string{"node1", "node3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.AllIntersections(4, 6): got unexpected values %v; want %v", got, want)
+	}
+
+	if got, ok := st.AnyIntersection(6, 7); !ok || got != "node3" {
+		t.Errorf("st.AnyIntersection(6, 7): got unexpected value %v, %v; want node3, true", got, ok)
+	}
+
+	var got []string
+	for _, e := range st.Intersections(0, 10) {
+		got = append(got, e.Val)
+	}
+	if want := []string{"node1", "node3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Intersections(0, 10): got unexpected values %v; want %v", got, want)
+	}
+
+	// Expired entries are still counted until they are purged.
+	if got, want := st.Size(), 3; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+
+	// Inserting the interval key again without a TTL makes it never expire.
+	st.Insert(6, 9, "node4")
+	clock.Advance(time.Hour)
+
+	if got, ok := st.Find(6, 9); !ok || got != "node4" {
+		t.Errorf("st.Find(6, 9): got unexpected value %v, %v; want node4, true", got, ok)
+	}
+}
+
+func TestSearchTree_InsertWithTTL_Queries(t *testing.T) {
+	var reads int
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		reads++
+		return now
+	}
+
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y },
+		TreeWithClock(clock))
+	defer mustBeValidTree(t, st.root)
+
+	st.Insert(1, 3, "node1")
+	st.InsertWithTTL(2, 6, "node2", -time.Second)
+	st.InsertWithTTL(4, 5, "node3", -time.Second)
+	st.InsertWithTTL(7, 9, "node4", time.Hour)
+
+	reads = 0
+	if got, want := allIntersectionsOf(st, 0, 10), []string{"node1", "node4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.AllIntersections(0, 10): got unexpected values %v; want %v", got, want)
+	}
+
+	if reads != 1 {
+		t.Errorf("st.AllIntersections(0, 10): got %d clock reads; want 1", reads)
+	}
+
+	if got, ok := st.Stab(4); ok {
+		t.Errorf("st.Stab(4): got unexpected expired values %v", got)
+	}
+
+	if got, ok := st.AnyStab(2); !ok || got != "node1" {
+		t.Errorf("st.AnyStab(2): got unexpected value %v, %v; want node1, true", got, ok)
+	}
+
+	if got, want := st.CountStab(2), 1; got != want {
+		t.Errorf("st.CountStab(2): got %d; want %d", got, want)
+	}
+
+	if got, want := st.CountIntersections(2, 8), 2; got != want {
+		t.Errorf("st.CountIntersections(2, 8): got %d; want %d", got, want)
+	}
+
+	if got, ok := st.ContainedIn(2, 6); ok {
+		t.Errorf("st.ContainedIn(2, 6): got unexpected expired values %v", got)
+	}
+
+	if got, ok := st.Containing(4, 5); ok {
+		t.Errorf("st.Containing(4, 5): got unexpected expired values %v", got)
+	}
+
+	if got, ok := st.Related(2, 6, Equals); ok {
+		t.Errorf("st.Related(2, 6, Equals): got unexpected expired values %v", got)
+	}
+
+	wantGaps := []Range[int]{{Start: 3, End: 7, Bounds: Open}}
+	if got := st.Gaps(1, 9); !reflect.DeepEqual(got, wantGaps) {
+		t.Errorf("st.Gaps(1, 9): got unexpected ranges %v; want %v", got, wantGaps)
+	}
+
+	if got, want := Coverage(st, 1, 9, intDistance), 4; got != want {
+		t.Errorf("Coverage(1, 9): got %v; want %v", got, want)
+	}
+
+	if depth, _ := st.MaxDepth(2, 6); depth != 1 {
+		t.Errorf("st.MaxDepth(2, 6): got depth %d; want 1", depth)
+	}
+
+	if got, ok := Nearest(st, 6, intDistance); !ok || got.Val != "node4" {
+		t.Errorf("Nearest(6): got unexpected entry %v, %v; want node4, true", got, ok)
+	}
+}
+
+func TestSearchTree_InsertWithTTL_Error(t *testing.T) {
+	st := NewSearchTree[int](func(x, y int) int { return x - y })
+
+	err := st.InsertWithTTL(5, 1, 0, time.Minute)
+	var wantErr InvalidIntervalError
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.InsertWithTTL(5, 1, 0, 1m): got error type %T; want it to be %T", err, wantErr)
+	}
+}
+
+func TestSearchTree_PurgeExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	st := NewSearchTreeWithOptions[int](func(x, y int) int { return x - y }, TreeWithClock(clock.Now))
+
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			st.Insert(i, i+10, i)
+			continue
+		}
+		st.InsertWithTTL(i, i+10, i, time.Duration(i%5)*time.Minute)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	// Entries with a TTL of 0, 1 or 2 minutes have expired.
+	var want int
+	for i := 0; i < 100; i++ {
+		if i%3 != 0 && i%5 <= 2 {
+			want++
+		}
+	}
+
+	if got := st.PurgeExpired(clock.Now()); got != want {
+		t.Errorf("st.PurgeExpired(): got %d removed entries; want %d", got, want)
+	}
+
+	mustBeValidTree(t, st.root)
+
+	for _, e := range st.All() {
+		if i := e.Val; i%3 != 0 && i%5 <= 2 {
+			t.Errorf("st.All(): got unexpected expired entry %v", e)
+		}
+	}
+
+	if got := st.PurgeExpired(clock.Now()); got != 0 {
+		t.Errorf("st.PurgeExpired(): got %d removed entries; want 0", got)
+	}
+}
+
+func TestSearchTree_PurgeExpired_Few(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	st := NewSearchTreeWithOptions[int](func(x, y int) int { return x - y }, TreeWithClock(clock.Now))
+
+	for i := 0; i < 100; i++ {
+		st.Insert(i, i+10, i)
+	}
+	st.InsertWithTTL(40, 45, 100, time.Minute)
+	st.InsertWithTTL(60, 65, 101, time.Hour)
+
+	clock.Advance(time.Minute)
+
+	if got := st.PurgeExpired(clock.Now()); got != 1 {
+		t.Errorf("st.PurgeExpired(): got %d removed entries; want 1", got)
+	}
+
+	mustBeValidTree(t, st.root)
+
+	if got, want := st.Size(), 101; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+
+	if got, want := st.root.Expiring, 1; got != want {
+		t.Errorf("st.root.Expiring: got %d; want %d", got, want)
+	}
+}
+
+func TestSearchTree_InsertBatch_ExpiresAt(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithClock(clock.Now))
+
+	expiresAt := clock.Now().Add(time.Minute)
+	err := st.InsertBatch([]Entry[string, int]{
+		{Start: 1, End: 5, Val: "node1"},
+		{Start: 3, End: 7, Val: "node2", ExpiresAt: expiresAt},
+	})
+	if err != nil {
+		t.Fatalf("st.InsertBatch(): got unexpected error %v", err)
+	}
+
+	err = st.Apply([]Op[string, int]{{Kind: OpUpsert, Start: 6, End: 9, Val: "node3", ExpiresAt: expiresAt}})
+	if err != nil {
+		t.Fatalf("st.Apply(): got unexpected error %v", err)
+	}
+
+	if got, _ := st.SelectEntry(1); !got.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("st.SelectEntry(1): got unexpected expiry time %v; want %v", got.ExpiresAt, expiresAt)
+	}
+
+	clock.Advance(time.Minute)
+
+	if got, want := allIntersectionsOf(st, 0, 10), []string{"node1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.AllIntersections(0, 10): got unexpected values %v; want %v", got, want)
+	}
+
+	if got := st.PurgeExpired(clock.Now()); got != 2 {
+		t.Errorf("st.PurgeExpired(): got %d removed entries; want 2", got)
+	}
+}
+
+func TestSearchTree_StartJanitor(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	st := NewSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithClock(clock.Now))
+
+	st.Insert(1, 5, "node1")
+	st.InsertWithTTL(3, 7, "node2", time.Minute)
+
+	tick := make(chan time.Time)
+	stop := st.StartJanitor(tick)
+
+	tick <- clock.Now()
+	// The second tick can only be received after the first purge is done.
+	tick <- clock.Now()
+
+	if got, want := st.Size(), 2; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+
+	clock.Advance(time.Minute)
+	tick <- clock.Now()
+	tick <- clock.Now()
+
+	if got, want := st.Size(), 1; got != want {
+		t.Errorf("st.Size(): got size %d; want %d", got, want)
+	}
+
+	stop()
+	stop()
+
+	select {
+	case tick <- clock.Now():
+		t.Error("StartJanitor: got tick received after stop")
+	default:
+	}
+}
+
+func allIntersectionsOf[V, T any](st *SearchTree[V, T], start, end T) []V {
+	vals, _ := st.AllIntersections(start, end)
+	return vals
+}
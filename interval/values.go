@@ -0,0 +1,108 @@
+package interval
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DuplicateValueError is a description of a value that is already stored under an interval key.
+type DuplicateValueError string
+
+// Error returns a string representation of the DuplicateValueError error.
+func (e DuplicateValueError) Error() string {
+	return string(e)
+}
+
+func newDuplicateValueError[V, T any](it interval[V, T], val V) error {
+	s := fmt.Sprintf("multi value interval search tree: value %v already exists for interval (%v, %v)", val, it.Start, it.End)
+	return DuplicateValueError(s)
+}
+
+// ContainsValue returns true if the given val is stored under the given start and end interval key
+// in the given tree; otherwise, false.
+//
+// Values are compared with the equality function configured with TreeWithValueEquality, if any,
+// or with the == operator otherwise. ContainsValue is a function rather than a method, so that V is required
+// to be comparable; to look for values that aren't, or with any other criteria, see ContainsValueFunc.
+func ContainsValue[V comparable, T any](st *MultiValueSearchTree[V, T], start, end T, val V) bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	eq := comparableEqual[V](st.config)
+	return st.view().ContainsValueFunc(start, end, func(v V) bool {
+		return eq(v, val)
+	})
+}
+
+// ContainsValueFunc returns true if any value for which match returns true is stored under
+// the given start and end interval key; otherwise, false.
+func (st *MultiValueSearchTree[V, T]) ContainsValueFunc(start, end T, match func(V) bool) bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.view().ContainsValueFunc(start, end, match)
+}
+
+// ContainsValueFunc returns true if any value for which match returns true is stored under
+// the given start and end interval key; otherwise, false.
+func (st *MultiValueSearchTreeView[V, T]) ContainsValueFunc(start, end T, match func(V) bool) bool {
+	intervl, ok := find(st.root, start, end, st.cmp)
+	if !ok {
+		return false
+	}
+
+	return slices.ContainsFunc(intervl.Vals, match)
+}
+
+// comparableEqual returns the equality function configured with TreeWithValueEquality, if any,
+// or the == operator otherwise.
+func comparableEqual[V comparable](config TreeConfig) EqualFunc[V] {
+	if eq := valueSetEqual[V](config); eq != nil {
+		return eq
+	}
+	return func(x, y V) bool {
+		return x == y
+	}
+}
+
+// valueSetEqual returns the equality function configured with TreeWithValueEquality,
+// or nil if the values of the tree aren't stored as sets.
+// Its type is checked against V when the tree is created, see NewMultiValueSearchTreeWithOptions.
+func valueSetEqual[V any](config TreeConfig) EqualFunc[V] {
+	eq, _ := config.valueEqual.(EqualFunc[V])
+	return eq
+}
+
+// duplicateValue returns the first value of intervl that is already stored under its interval key
+// in the tree rooted at n, or that is repeated in intervl, according to eq.
+func duplicateValue[V, T any](n *node[V, T], intervl interval[V, T], eq EqualFunc[V], cmp CmpFunc[T]) (dup V, ok bool) {
+	stored, _ := find(n, intervl.Start, intervl.End, cmp)
+	for i, v := range intervl.Vals {
+		if containsValue(stored.Vals, v, eq) || containsValue(intervl.Vals[:i], v, eq) {
+			return v, true
+		}
+	}
+
+	return dup, false
+}
+
+// appendUnique appends to dst the values of vals that aren't in dst yet, according to eq.
+// The values are simply appended if eq is nil.
+func appendUnique[V any](dst, vals []V, eq EqualFunc[V]) []V {
+	if eq == nil {
+		return append(dst, vals...)
+	}
+
+	for _, v := range vals {
+		if !containsValue(dst, v, eq) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+func containsValue[V any](vals []V, val V, eq EqualFunc[V]) bool {
+	return slices.ContainsFunc(vals, func(v V) bool {
+		return eq(v, val)
+	})
+}
@@ -0,0 +1,200 @@
+package interval
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestContainsValue(t *testing.T) {
+	st := NewMultiValueSearchTree[string](func(x, y int) int { return x - y })
+
+	st.Insert(1, 5, "value1", "value2")
+	st.Insert(5, 9, "value3")
+
+	testCases := []struct {
+		start, end int
+		val        string
+		want       bool
+	}{
+		{start: 1, end: 5, val: "value1", want: true},
+		{start: 1, end: 5, val: "value2", want: true},
+		{start: 1, end: 5, val: "value3", want: false},
+		{start: 5, end: 9, val: "value3", want: true},
+		{start: 1, end: 9, val: "value1", want: false},
+	}
+
+	for _, tc := range testCases {
+		if got := ContainsValue(st, tc.start, tc.end, tc.val); got != tc.want {
+			t.Errorf("ContainsValue(st, %v, %v, %v): got %v; want %v", tc.start, tc.end, tc.val, got, tc.want)
+		}
+	}
+}
+
+func TestContainsValue_ValueEquality(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(strings.EqualFold))
+
+	st.Insert(1, 5, "Value1")
+
+	if !ContainsValue(st, 1, 5, "value1") {
+		t.Errorf("ContainsValue(st, 1, 5, value1): got false; want true")
+	}
+}
+
+func TestMultiValueSearchTree_ContainsValueFunc(t *testing.T) {
+	st := NewMultiValueSearchTree[[]string](func(x, y int) int { return x - y })
+
+	st.Insert(1, 5, []string{"value1", "value2"}, []string{"value3"})
+
+	testCases := []struct {
+		start, end int
+		val        string
+		want       bool
+	}{
+		{start: 1, end: 5, val: "value2", want: true},
+		{start: 1, end: 5, val: "value3", want: true},
+		{start: 1, end: 5, val: "value4", want: false},
+		{start: 1, end: 9, val: "value1", want: false},
+	}
+
+	for _, tc := range testCases {
+		match := func(v []string) bool { return slices.Contains(v, tc.val) }
+		if got := st.ContainsValueFunc(tc.start, tc.end, match); got != tc.want {
+			t.Errorf("st.ContainsValueFunc(%v, %v, %v): got %v; want %v", tc.start, tc.end, tc.val, got, tc.want)
+		}
+	}
+}
+
+func TestMultiValueSearchTree_Insert_ValueEquality(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(strings.EqualFold))
+	defer mustBeValidTree(t, st.root)
+
+	var wantErr DuplicateValueError
+	if err := st.Insert(1, 5, "value1", "VALUE1", "value2"); !errors.As(err, &wantErr) {
+		t.Errorf("st.Insert(1, 5, value1, VALUE1, value2): got error type %T; want it to be %T", err, wantErr)
+	}
+
+	if !st.IsEmpty() {
+		t.Errorf("st.Insert(1, 5, value1, VALUE1, value2): got %d entries; want an empty tree", st.Size())
+	}
+
+	if err := st.Insert(1, 5, "value1", "value2"); err != nil {
+		t.Errorf("st.Insert(1, 5, value1, value2): got unexpected error %v", err)
+	}
+
+	// Inserting any duplicate value is a no-op.
+	if err := st.InsertWithBounds(1, 5, Open, "value3", "Value2"); !errors.As(err, &wantErr) {
+		t.Errorf("st.InsertWithBounds(1, 5, Open, value3, Value2): got error type %T; want it to be %T", err, wantErr)
+	}
+
+	want := Entry[string, int]{Start: 1, End: 5, Bounds: Closed, Vals: []string{"value1", "value2"}}
+	if got, _ := st.MinEntry(); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.MinEntry(): got unexpected entry %v; want %v", got, want)
+	}
+
+	if err := st.Insert(1, 5, "value3"); err != nil {
+		t.Errorf("st.Insert(1, 5, value3): got unexpected error %v", err)
+	}
+
+	if err := st.Upsert(5, 9, "value4", "Value4"); !errors.As(err, &wantErr) {
+		t.Errorf("st.Upsert(5, 9, value4, Value4): got error type %T; want it to be %T", err, wantErr)
+	}
+
+	st.Upsert(5, 9, "value4")
+	st.InsertBatch([]Entry[string, int]{
+		{Start: 5, End: 9, Vals: []string{"value5"}},
+		{Start: 9, End: 12, Vals: []string{"value6"}},
+	})
+
+	wantEntries := []Entry[string, int]{
+		{Start: 1, End: 5, Vals: []string{"value1", "value2", "value3"}},
+		{Start: 5, End: 9, Vals: []string{"value4", "value5"}},
+		{Start: 9, End: 12, Vals: []string{"value6"}},
+	}
+	if got := entriesOf((*SearchTree[string, int])(st)); !reflect.DeepEqual(got, wantEntries) {
+		t.Errorf("st.All(): got unexpected entries %v; want %v", got, wantEntries)
+	}
+}
+
+func TestMultiValueSearchTree_Apply_ValueEquality(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(strings.EqualFold))
+	st.Insert(1, 5, "value1")
+
+	snap := st.Snapshot()
+
+	err := st.Apply([]Op[string, int]{
+		{Kind: OpInsert, Start: 5, End: 9, Vals: []string{"value2"}},
+		{Kind: OpDelete, Start: 1, End: 5},
+		{Kind: OpInsert, Start: 5, End: 9, Vals: []string{"VALUE2"}},
+	})
+
+	var wantErr DuplicateValueError
+	if !errors.As(err, &wantErr) {
+		t.Fatalf("st.Apply: got error type %T; want it to be %T", err, wantErr)
+	}
+
+	want := []Entry[string, int]{{Start: 1, End: 5, Vals: []string{"value1"}}}
+	if got := entriesOf((*SearchTree[string, int])(st)); !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Apply: got unexpected entries %v after error; want %v", got, want)
+	}
+
+	err = st.Apply([]Op[string, int]{{Kind: OpUpsert, Start: 1, End: 5, Vals: []string{"value2", "VALUE2"}}})
+	if !errors.As(err, &wantErr) {
+		t.Errorf("st.Apply: got error type %T; want it to be %T", err, wantErr)
+	}
+
+	st.Insert(9, 12, "value3")
+	if got := entriesOfView((*SearchTreeView[string, int])(snap)); !reflect.DeepEqual(got, want) {
+		t.Errorf("snap.All(): got unexpected entries %v; want %v", got, want)
+	}
+}
+
+func TestMultiValueSearchTree_Load_ValueEquality(t *testing.T) {
+	cmpFunc := func(x, y int) int { return x - y }
+	eq := TreeWithValueEquality(func(x, y int) bool { return x == y })
+
+	// Repeated values are rejected whether they are in the same entry or in entries with the same interval key.
+	for _, vals := range [][]int{{1, 2, 1}, {2, 3}} {
+		_, err := NewMultiValueSearchTreeFromSorted(cmpFunc, []Entry[int, int]{
+			{Start: 1, End: 5, Vals: []int{3}},
+			{Start: 1, End: 5, Vals: vals},
+		}, eq)
+
+		var wantErr DuplicateValueError
+		if !errors.As(err, &wantErr) {
+			t.Errorf("NewMultiValueSearchTreeFromSorted(%v): got error type %T; want it to be %T", vals, err, wantErr)
+		}
+	}
+
+	st, err := NewMultiValueSearchTreeFromSorted(cmpFunc, []Entry[int, int]{
+		{Start: 1, End: 5, Vals: []int{1, 2}},
+		{Start: 1, End: 5, Vals: []int{3}},
+	}, eq)
+	if err != nil {
+		t.Fatalf("NewMultiValueSearchTreeFromSorted: got unexpected error %v", err)
+	}
+
+	if got, want := mustFind(t, st, 1, 5), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Find(1, 5): got unexpected values %v; want %v", got, want)
+	}
+
+	other := NewMultiValueSearchTree[int](cmpFunc)
+	other.Insert(1, 5, 3, 4)
+
+	if got, want := mustFind(t, st.Union(other), 1, 5), []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Union(other).Find(1, 5): got unexpected values %v; want %v", got, want)
+	}
+}
+
+func TestDeleteValue_ValueEquality(t *testing.T) {
+	st := NewMultiValueSearchTreeWithOptions[string](func(x, y int) int { return x - y }, TreeWithValueEquality(strings.EqualFold))
+
+	st.Insert(1, 5, "value1", "value2")
+	DeleteValue(st, 1, 5, "VALUE1")
+
+	if got, want := mustFind(t, st, 1, 5), []string{"value2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("st.Find(1, 5): got unexpected values %v; want %v", got, want)
+	}
+}